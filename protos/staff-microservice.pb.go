@@ -9,6 +9,8 @@ package protos
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,13 +22,533 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Unique StaffMember fields identifying the staff member an upsert updates.
+// Upserting on the staffID requires one, so only importers, who may choose the staffID, can use it.
+type UpsertConflictTarget int32
+
+const (
+	UpsertConflictTarget_UPSERT_ON_STAFF_ID UpsertConflictTarget = 0
+	UpsertConflictTarget_UPSERT_ON_EMAIL    UpsertConflictTarget = 1
+)
+
+// Enum value maps for UpsertConflictTarget.
+var (
+	UpsertConflictTarget_name = map[int32]string{
+		0: "UPSERT_ON_STAFF_ID",
+		1: "UPSERT_ON_EMAIL",
+	}
+	UpsertConflictTarget_value = map[string]int32{
+		"UPSERT_ON_STAFF_ID": 0,
+		"UPSERT_ON_EMAIL":    1,
+	}
+)
+
+func (x UpsertConflictTarget) Enum() *UpsertConflictTarget {
+	p := new(UpsertConflictTarget)
+	*p = x
+	return p
+}
+
+func (x UpsertConflictTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpsertConflictTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_staff_microservice_proto_enumTypes[0].Descriptor()
+}
+
+func (UpsertConflictTarget) Type() protoreflect.EnumType {
+	return &file_staff_microservice_proto_enumTypes[0]
+}
+
+func (x UpsertConflictTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpsertConflictTarget.Descriptor instead.
+func (UpsertConflictTarget) EnumDescriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{0}
+}
+
+// Outcomes of an upsert.
+type UpsertResult int32
+
+const (
+	UpsertResult_UPSERT_RESULT_UNSPECIFIED UpsertResult = 0
+	UpsertResult_UPSERT_RESULT_CREATED     UpsertResult = 1
+	UpsertResult_UPSERT_RESULT_UPDATED     UpsertResult = 2
+	// The existing staff member already had the given fields, and was not written.
+	UpsertResult_UPSERT_RESULT_UNCHANGED UpsertResult = 3
+)
+
+// Enum value maps for UpsertResult.
+var (
+	UpsertResult_name = map[int32]string{
+		0: "UPSERT_RESULT_UNSPECIFIED",
+		1: "UPSERT_RESULT_CREATED",
+		2: "UPSERT_RESULT_UPDATED",
+		3: "UPSERT_RESULT_UNCHANGED",
+	}
+	UpsertResult_value = map[string]int32{
+		"UPSERT_RESULT_UNSPECIFIED": 0,
+		"UPSERT_RESULT_CREATED":     1,
+		"UPSERT_RESULT_UPDATED":     2,
+		"UPSERT_RESULT_UNCHANGED":   3,
+	}
+)
+
+func (x UpsertResult) Enum() *UpsertResult {
+	p := new(UpsertResult)
+	*p = x
+	return p
+}
+
+func (x UpsertResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpsertResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_staff_microservice_proto_enumTypes[1].Descriptor()
+}
+
+func (UpsertResult) Type() protoreflect.EnumType {
+	return &file_staff_microservice_proto_enumTypes[1]
+}
+
+func (x UpsertResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpsertResult.Descriptor instead.
+func (UpsertResult) EnumDescriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{1}
+}
+
+// Ordering options for listing staff members.
+type StaffMemberOrderBy int32
+
+const (
+	StaffMemberOrderBy_ORDER_BY_UNSPECIFIED StaffMemberOrderBy = 0
+	StaffMemberOrderBy_ORDER_BY_LAST_NAME   StaffMemberOrderBy = 1
+	StaffMemberOrderBy_ORDER_BY_CREATED_AT  StaffMemberOrderBy = 2
+)
+
+// Enum value maps for StaffMemberOrderBy.
+var (
+	StaffMemberOrderBy_name = map[int32]string{
+		0: "ORDER_BY_UNSPECIFIED",
+		1: "ORDER_BY_LAST_NAME",
+		2: "ORDER_BY_CREATED_AT",
+	}
+	StaffMemberOrderBy_value = map[string]int32{
+		"ORDER_BY_UNSPECIFIED": 0,
+		"ORDER_BY_LAST_NAME":   1,
+		"ORDER_BY_CREATED_AT":  2,
+	}
+)
+
+func (x StaffMemberOrderBy) Enum() *StaffMemberOrderBy {
+	p := new(StaffMemberOrderBy)
+	*p = x
+	return p
+}
+
+func (x StaffMemberOrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StaffMemberOrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_staff_microservice_proto_enumTypes[2].Descriptor()
+}
+
+func (StaffMemberOrderBy) Type() protoreflect.EnumType {
+	return &file_staff_microservice_proto_enumTypes[2]
+}
+
+func (x StaffMemberOrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StaffMemberOrderBy.Descriptor instead.
+func (StaffMemberOrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{2}
+}
+
+// How a batch handles failing items.
+type BatchMode int32
+
+const (
+	// Apply either every item or none of them; the first failing item fails the whole request.
+	BatchMode_BATCH_MODE_ALL_OR_NOTHING BatchMode = 0
+	// Apply the items that succeed and report the status of every item.
+	BatchMode_BATCH_MODE_BEST_EFFORT BatchMode = 1
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_ALL_OR_NOTHING",
+		1: "BATCH_MODE_BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_ALL_OR_NOTHING": 0,
+		"BATCH_MODE_BEST_EFFORT":    1,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_staff_microservice_proto_enumTypes[3].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_staff_microservice_proto_enumTypes[3]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{3}
+}
+
+// File formats of an imported roster.
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_CSV  ImportFormat = 0
+	ImportFormat_IMPORT_FORMAT_XLSX ImportFormat = 1
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_CSV",
+		1: "IMPORT_FORMAT_XLSX",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_CSV":  0,
+		"IMPORT_FORMAT_XLSX": 1,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_staff_microservice_proto_enumTypes[4].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_staff_microservice_proto_enumTypes[4]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{4}
+}
+
+// How the rows of an imported roster are matched to existing staff members.
+type ImportMatchBy int32
+
+const (
+	ImportMatchBy_IMPORT_MATCH_BY_EMAIL    ImportMatchBy = 0
+	ImportMatchBy_IMPORT_MATCH_BY_STAFF_ID ImportMatchBy = 1
+)
+
+// Enum value maps for ImportMatchBy.
+var (
+	ImportMatchBy_name = map[int32]string{
+		0: "IMPORT_MATCH_BY_EMAIL",
+		1: "IMPORT_MATCH_BY_STAFF_ID",
+	}
+	ImportMatchBy_value = map[string]int32{
+		"IMPORT_MATCH_BY_EMAIL":    0,
+		"IMPORT_MATCH_BY_STAFF_ID": 1,
+	}
+)
+
+func (x ImportMatchBy) Enum() *ImportMatchBy {
+	p := new(ImportMatchBy)
+	*p = x
+	return p
+}
+
+func (x ImportMatchBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMatchBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_staff_microservice_proto_enumTypes[5].Descriptor()
+}
+
+func (ImportMatchBy) Type() protoreflect.EnumType {
+	return &file_staff_microservice_proto_enumTypes[5]
+}
+
+func (x ImportMatchBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMatchBy.Descriptor instead.
+func (ImportMatchBy) EnumDescriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{5}
+}
+
+// Outcome of importing a single row.
+type ImportRowStatus int32
+
+const (
+	ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED ImportRowStatus = 0
+	ImportRowStatus_IMPORT_ROW_CREATED            ImportRowStatus = 1
+	ImportRowStatus_IMPORT_ROW_UPDATED            ImportRowStatus = 2
+	// The matched staff member already had the values of the row.
+	ImportRowStatus_IMPORT_ROW_SKIPPED ImportRowStatus = 3
+	ImportRowStatus_IMPORT_ROW_FAILED  ImportRowStatus = 4
+)
+
+// Enum value maps for ImportRowStatus.
+var (
+	ImportRowStatus_name = map[int32]string{
+		0: "IMPORT_ROW_STATUS_UNSPECIFIED",
+		1: "IMPORT_ROW_CREATED",
+		2: "IMPORT_ROW_UPDATED",
+		3: "IMPORT_ROW_SKIPPED",
+		4: "IMPORT_ROW_FAILED",
+	}
+	ImportRowStatus_value = map[string]int32{
+		"IMPORT_ROW_STATUS_UNSPECIFIED": 0,
+		"IMPORT_ROW_CREATED":            1,
+		"IMPORT_ROW_UPDATED":            2,
+		"IMPORT_ROW_SKIPPED":            3,
+		"IMPORT_ROW_FAILED":             4,
+	}
+)
+
+func (x ImportRowStatus) Enum() *ImportRowStatus {
+	p := new(ImportRowStatus)
+	*p = x
+	return p
+}
+
+func (x ImportRowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_staff_microservice_proto_enumTypes[6].Descriptor()
+}
+
+func (ImportRowStatus) Type() protoreflect.EnumType {
+	return &file_staff_microservice_proto_enumTypes[6]
+}
+
+func (x ImportRowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowStatus.Descriptor instead.
+func (ImportRowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{6}
+}
+
+// Kinds of changes recorded in the audit log.
+type StaffAuditAction int32
+
+const (
+	StaffAuditAction_AUDIT_ACTION_UNSPECIFIED StaffAuditAction = 0
+	StaffAuditAction_AUDIT_ACTION_CREATE      StaffAuditAction = 1
+	StaffAuditAction_AUDIT_ACTION_UPDATE      StaffAuditAction = 2
+	StaffAuditAction_AUDIT_ACTION_DELETE      StaffAuditAction = 3
+	StaffAuditAction_AUDIT_ACTION_RESTORE     StaffAuditAction = 4
+	StaffAuditAction_AUDIT_ACTION_PURGE       StaffAuditAction = 5
+)
+
+// Enum value maps for StaffAuditAction.
+var (
+	StaffAuditAction_name = map[int32]string{
+		0: "AUDIT_ACTION_UNSPECIFIED",
+		1: "AUDIT_ACTION_CREATE",
+		2: "AUDIT_ACTION_UPDATE",
+		3: "AUDIT_ACTION_DELETE",
+		4: "AUDIT_ACTION_RESTORE",
+		5: "AUDIT_ACTION_PURGE",
+	}
+	StaffAuditAction_value = map[string]int32{
+		"AUDIT_ACTION_UNSPECIFIED": 0,
+		"AUDIT_ACTION_CREATE":      1,
+		"AUDIT_ACTION_UPDATE":      2,
+		"AUDIT_ACTION_DELETE":      3,
+		"AUDIT_ACTION_RESTORE":     4,
+		"AUDIT_ACTION_PURGE":       5,
+	}
+)
+
+func (x StaffAuditAction) Enum() *StaffAuditAction {
+	p := new(StaffAuditAction)
+	*p = x
+	return p
+}
+
+func (x StaffAuditAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StaffAuditAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_staff_microservice_proto_enumTypes[7].Descriptor()
+}
+
+func (StaffAuditAction) Type() protoreflect.EnumType {
+	return &file_staff_microservice_proto_enumTypes[7]
+}
+
+func (x StaffAuditAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StaffAuditAction.Descriptor instead.
+func (StaffAuditAction) EnumDescriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{7}
+}
+
+// Kinds of staff member changes announced to other services.
+type StaffEventType int32
+
+const (
+	StaffEventType_STAFF_EVENT_TYPE_UNSPECIFIED StaffEventType = 0
+	StaffEventType_STAFF_CREATED                StaffEventType = 1
+	StaffEventType_STAFF_UPDATED                StaffEventType = 2
+	StaffEventType_STAFF_DELETED                StaffEventType = 3
+)
+
+// Enum value maps for StaffEventType.
+var (
+	StaffEventType_name = map[int32]string{
+		0: "STAFF_EVENT_TYPE_UNSPECIFIED",
+		1: "STAFF_CREATED",
+		2: "STAFF_UPDATED",
+		3: "STAFF_DELETED",
+	}
+	StaffEventType_value = map[string]int32{
+		"STAFF_EVENT_TYPE_UNSPECIFIED": 0,
+		"STAFF_CREATED":                1,
+		"STAFF_UPDATED":                2,
+		"STAFF_DELETED":                3,
+	}
+)
+
+func (x StaffEventType) Enum() *StaffEventType {
+	p := new(StaffEventType)
+	*p = x
+	return p
+}
+
+func (x StaffEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StaffEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_staff_microservice_proto_enumTypes[8].Descriptor()
+}
+
+func (StaffEventType) Type() protoreflect.EnumType {
+	return &file_staff_microservice_proto_enumTypes[8]
+}
+
+func (x StaffEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StaffEventType.Descriptor instead.
+func (StaffEventType) EnumDescriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{8}
+}
+
+// File formats of an exported staff directory.
+type ExportFormat int32
+
+const (
+	// Comma-separated values with a header row, in UTF-8 with a byte order mark.
+	// The columns have the names of the StaffMember fields, so the file can be imported back.
+	ExportFormat_EXPORT_FORMAT_CSV ExportFormat = 0
+	// A StaffMember JSON object per line.
+	ExportFormat_EXPORT_FORMAT_NDJSON ExportFormat = 1
+	// A vCard 4.0 per staff member, with their name, email, phone number, title and office.
+	ExportFormat_EXPORT_FORMAT_VCARD ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_CSV",
+		1: "EXPORT_FORMAT_NDJSON",
+		2: "EXPORT_FORMAT_VCARD",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_CSV":    0,
+		"EXPORT_FORMAT_NDJSON": 1,
+		"EXPORT_FORMAT_VCARD":  2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_staff_microservice_proto_enumTypes[9].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_staff_microservice_proto_enumTypes[9]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{9}
+}
+
 // Request message for getting a staff member.
+// includeDeleted also returns a deleted staff member and is allowed for admins only.
 type GetStaffMemberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StaffID       string                 `protobuf:"bytes,2,opt,name=staffID,proto3" json:"staffID,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StaffID        string                 `protobuf:"bytes,2,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,3,opt,name=includeDeleted,proto3" json:"includeDeleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetStaffMemberRequest) Reset() {
@@ -73,6 +595,13 @@ func (x *GetStaffMemberRequest) GetStaffID() string {
 	return ""
 }
 
+func (x *GetStaffMemberRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 // Response message contains the staff member.
 type GetStaffMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -119,6 +648,7 @@ func (x *GetStaffMemberResponse) GetStaffMember() *StaffMember {
 }
 
 // Request message for creating a new staff member.
+// The staffID is assigned by the server; only importers may choose it.
 type CreateStaffMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -171,7 +701,8 @@ func (x *CreateStaffMemberRequest) GetStaffMember() *StaffMember {
 	return nil
 }
 
-// Response message contains the new staff member details.
+// Response message contains the new staff member details,
+// including the assigned staffID and timestamps.
 type CreateStaffMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StaffMember   *StaffMember           `protobuf:"bytes,1,opt,name=staffMember,proto3" json:"staffMember,omitempty"`
@@ -217,10 +748,16 @@ func (x *CreateStaffMemberResponse) GetStaffMember() *StaffMember {
 }
 
 // Request message for updating a staff member.
+// updateMask lists the StaffMember fields to update, such as "title" or "office".
+// Masked fields are set to their value in staffMember, even if it is empty.
+// Without a mask, only the non-empty fields of staffMember are updated.
+// If etag is set, the update is aborted unless it matches the current etag of the staff member.
 type UpdateStaffMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	StaffMember   *StaffMember           `protobuf:"bytes,2,opt,name=staffMember,proto3" json:"staffMember,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	Etag          string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -269,6 +806,20 @@ func (x *UpdateStaffMemberRequest) GetStaffMember() *StaffMember {
 	return nil
 }
 
+func (x *UpdateStaffMemberRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateStaffMemberRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 // Request message with the updated staff member details.
 type UpdateStaffMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -314,29 +865,34 @@ func (x *UpdateStaffMemberResponse) GetStaffMember() *StaffMember {
 	return nil
 }
 
-// Request message for deleting a staff member.
-type DeleteStaffMemberRequest struct {
+// Request message for creating or updating a staff member.
+// If a staff member with the same onConflict field exists, every field of staffMember but the
+// staffID is set on it, and otherwise staffMember is created as by CreateStaffMemberRequest.
+// Deleted staff members are not updated, and must be restored first. Upserting on the email
+// fails if staffMember has a staffID other than the one of the staff member with the email.
+type UpsertStaffMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StaffID       string                 `protobuf:"bytes,2,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	StaffMember   *StaffMember           `protobuf:"bytes,2,opt,name=staffMember,proto3" json:"staffMember,omitempty"`
+	OnConflict    UpsertConflictTarget   `protobuf:"varint,3,opt,name=onConflict,proto3,enum=staff.UpsertConflictTarget" json:"onConflict,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteStaffMemberRequest) Reset() {
-	*x = DeleteStaffMemberRequest{}
+func (x *UpsertStaffMemberRequest) Reset() {
+	*x = UpsertStaffMemberRequest{}
 	mi := &file_staff_microservice_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteStaffMemberRequest) String() string {
+func (x *UpsertStaffMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteStaffMemberRequest) ProtoMessage() {}
+func (*UpsertStaffMemberRequest) ProtoMessage() {}
 
-func (x *DeleteStaffMemberRequest) ProtoReflect() protoreflect.Message {
+func (x *UpsertStaffMemberRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_staff_microservice_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -348,47 +904,2001 @@ func (x *DeleteStaffMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteStaffMemberRequest.ProtoReflect.Descriptor instead.
-func (*DeleteStaffMemberRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpsertStaffMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpsertStaffMemberRequest) Descriptor() ([]byte, []int) {
 	return file_staff_microservice_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DeleteStaffMemberRequest) GetToken() string {
+func (x *UpsertStaffMemberRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *DeleteStaffMemberRequest) GetStaffID() string {
+func (x *UpsertStaffMemberRequest) GetStaffMember() *StaffMember {
 	if x != nil {
-		return x.StaffID
+		return x.StaffMember
 	}
-	return ""
+	return nil
 }
 
-// Response message for deleting a staff member - no data returned.
+func (x *UpsertStaffMemberRequest) GetOnConflict() UpsertConflictTarget {
+	if x != nil {
+		return x.OnConflict
+	}
+	return UpsertConflictTarget_UPSERT_ON_STAFF_ID
+}
+
+// Response message contains the staff member after the upsert and whether it was created or updated.
+type UpsertStaffMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaffMember   *StaffMember           `protobuf:"bytes,1,opt,name=staffMember,proto3" json:"staffMember,omitempty"`
+	Result        UpsertResult           `protobuf:"varint,2,opt,name=result,proto3,enum=staff.UpsertResult" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertStaffMemberResponse) Reset() {
+	*x = UpsertStaffMemberResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertStaffMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertStaffMemberResponse) ProtoMessage() {}
+
+func (x *UpsertStaffMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertStaffMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpsertStaffMemberResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpsertStaffMemberResponse) GetStaffMember() *StaffMember {
+	if x != nil {
+		return x.StaffMember
+	}
+	return nil
+}
+
+func (x *UpsertStaffMemberResponse) GetResult() UpsertResult {
+	if x != nil {
+		return x.Result
+	}
+	return UpsertResult_UPSERT_RESULT_UNSPECIFIED
+}
+
+// Request message for deleting a staff member.
+// The staff member is only marked as deleted and can be restored until it is purged.
+// If etag is set, the deletion is aborted unless it matches the current etag of the staff member.
+type DeleteStaffMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StaffID       string                 `protobuf:"bytes,2,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	Etag          string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStaffMemberRequest) Reset() {
+	*x = DeleteStaffMemberRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStaffMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStaffMemberRequest) ProtoMessage() {}
+
+func (x *DeleteStaffMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStaffMemberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStaffMemberRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteStaffMemberRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteStaffMemberRequest) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+func (x *DeleteStaffMemberRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// Response message for deleting a staff member - no data returned.
 type DeleteStaffMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteStaffMemberResponse) Reset() {
-	*x = DeleteStaffMemberResponse{}
-	mi := &file_staff_microservice_proto_msgTypes[7]
+func (x *DeleteStaffMemberResponse) Reset() {
+	*x = DeleteStaffMemberResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStaffMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStaffMemberResponse) ProtoMessage() {}
+
+func (x *DeleteStaffMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStaffMemberResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStaffMemberResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{9}
+}
+
+// Request message for restoring a deleted staff member.
+type RestoreStaffMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StaffID       string                 `protobuf:"bytes,2,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreStaffMemberRequest) Reset() {
+	*x = RestoreStaffMemberRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreStaffMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStaffMemberRequest) ProtoMessage() {}
+
+func (x *RestoreStaffMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStaffMemberRequest.ProtoReflect.Descriptor instead.
+func (*RestoreStaffMemberRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreStaffMemberRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RestoreStaffMemberRequest) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+// Response message contains the restored staff member.
+type RestoreStaffMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaffMember   *StaffMember           `protobuf:"bytes,1,opt,name=staffMember,proto3" json:"staffMember,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreStaffMemberResponse) Reset() {
+	*x = RestoreStaffMemberResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreStaffMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStaffMemberResponse) ProtoMessage() {}
+
+func (x *RestoreStaffMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStaffMemberResponse.ProtoReflect.Descriptor instead.
+func (*RestoreStaffMemberResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreStaffMemberResponse) GetStaffMember() *StaffMember {
+	if x != nil {
+		return x.StaffMember
+	}
+	return nil
+}
+
+// Request message for permanently removing a deleted staff member.
+type PurgeStaffMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StaffID       string                 `protobuf:"bytes,2,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeStaffMemberRequest) Reset() {
+	*x = PurgeStaffMemberRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeStaffMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeStaffMemberRequest) ProtoMessage() {}
+
+func (x *PurgeStaffMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeStaffMemberRequest.ProtoReflect.Descriptor instead.
+func (*PurgeStaffMemberRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PurgeStaffMemberRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PurgeStaffMemberRequest) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+// Response message for purging a staff member - no data returned.
+type PurgeStaffMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeStaffMemberResponse) Reset() {
+	*x = PurgeStaffMemberResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeStaffMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeStaffMemberResponse) ProtoMessage() {}
+
+func (x *PurgeStaffMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeStaffMemberResponse.ProtoReflect.Descriptor instead.
+func (*PurgeStaffMemberResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{13}
+}
+
+// Request message for listing staff members.
+// pageToken is the opaque nextPageToken returned by a previous call and must be
+// used with the same orderBy and descending values.
+// includeDeleted also lists deleted staff members and is allowed for admins only.
+type ListStaffMembersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	Title          string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Office         string                 `protobuf:"bytes,5,opt,name=office,proto3" json:"office,omitempty"`
+	OrderBy        StaffMemberOrderBy     `protobuf:"varint,6,opt,name=orderBy,proto3,enum=staff.StaffMemberOrderBy" json:"orderBy,omitempty"`
+	Descending     bool                   `protobuf:"varint,7,opt,name=descending,proto3" json:"descending,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,8,opt,name=includeDeleted,proto3" json:"includeDeleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListStaffMembersRequest) Reset() {
+	*x = ListStaffMembersRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffMembersRequest) ProtoMessage() {}
+
+func (x *ListStaffMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListStaffMembersRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListStaffMembersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListStaffMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStaffMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListStaffMembersRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListStaffMembersRequest) GetOffice() string {
+	if x != nil {
+		return x.Office
+	}
+	return ""
+}
+
+func (x *ListStaffMembersRequest) GetOrderBy() StaffMemberOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return StaffMemberOrderBy_ORDER_BY_UNSPECIFIED
+}
+
+func (x *ListStaffMembersRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListStaffMembersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// Response message contains a page of staff members.
+// nextPageToken is empty when there are no more pages.
+type ListStaffMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaffMembers  []*StaffMember         `protobuf:"bytes,1,rep,name=staffMembers,proto3" json:"staffMembers,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffMembersResponse) Reset() {
+	*x = ListStaffMembersResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffMembersResponse) ProtoMessage() {}
+
+func (x *ListStaffMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListStaffMembersResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListStaffMembersResponse) GetStaffMembers() []*StaffMember {
+	if x != nil {
+		return x.StaffMembers
+	}
+	return nil
+}
+
+func (x *ListStaffMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListStaffMembersResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Request message for searching staff members.
+// The query is matched case-insensitively, ignoring accents and Hebrew niqqud,
+// by prefix and by fuzzy similarity against names, email and office.
+type SearchStaffMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStaffMembersRequest) Reset() {
+	*x = SearchStaffMembersRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStaffMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStaffMembersRequest) ProtoMessage() {}
+
+func (x *SearchStaffMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStaffMembersRequest.ProtoReflect.Descriptor instead.
+func (*SearchStaffMembersRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchStaffMembersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SearchStaffMembersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchStaffMembersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Response message contains the matching staff members, best match first.
+type SearchStaffMembersResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*StaffMemberSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStaffMembersResponse) Reset() {
+	*x = SearchStaffMembersResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStaffMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStaffMembersResponse) ProtoMessage() {}
+
+func (x *SearchStaffMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStaffMembersResponse.ProtoReflect.Descriptor instead.
+func (*SearchStaffMembersResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchStaffMembersResponse) GetResults() []*StaffMemberSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// A single search match with its relevance score between 0 and 1.
+type StaffMemberSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaffMember   *StaffMember           `protobuf:"bytes,1,opt,name=staffMember,proto3" json:"staffMember,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffMemberSearchResult) Reset() {
+	*x = StaffMemberSearchResult{}
+	mi := &file_staff_microservice_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffMemberSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffMemberSearchResult) ProtoMessage() {}
+
+func (x *StaffMemberSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffMemberSearchResult.ProtoReflect.Descriptor instead.
+func (*StaffMemberSearchResult) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StaffMemberSearchResult) GetStaffMember() *StaffMember {
+	if x != nil {
+		return x.StaffMember
+	}
+	return nil
+}
+
+func (x *StaffMemberSearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// Request message for getting several staff members.
+// At most 500 staffIDs may be requested at once.
+type BatchGetStaffMembersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StaffIDs       []string               `protobuf:"bytes,2,rep,name=staffIDs,proto3" json:"staffIDs,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,3,opt,name=includeDeleted,proto3" json:"includeDeleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchGetStaffMembersRequest) Reset() {
+	*x = BatchGetStaffMembersRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetStaffMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetStaffMembersRequest) ProtoMessage() {}
+
+func (x *BatchGetStaffMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetStaffMembersRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetStaffMembersRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchGetStaffMembersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BatchGetStaffMembersRequest) GetStaffIDs() []string {
+	if x != nil {
+		return x.StaffIDs
+	}
+	return nil
+}
+
+func (x *BatchGetStaffMembersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// Response message contains the staff members found, in the order of the requested staffIDs,
+// and the requested staffIDs that were not found.
+type BatchGetStaffMembersResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StaffMembers    []*StaffMember         `protobuf:"bytes,1,rep,name=staffMembers,proto3" json:"staffMembers,omitempty"`
+	MissingStaffIDs []string               `protobuf:"bytes,2,rep,name=missingStaffIDs,proto3" json:"missingStaffIDs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchGetStaffMembersResponse) Reset() {
+	*x = BatchGetStaffMembersResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetStaffMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetStaffMembersResponse) ProtoMessage() {}
+
+func (x *BatchGetStaffMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetStaffMembersResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetStaffMembersResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchGetStaffMembersResponse) GetStaffMembers() []*StaffMember {
+	if x != nil {
+		return x.StaffMembers
+	}
+	return nil
+}
+
+func (x *BatchGetStaffMembersResponse) GetMissingStaffIDs() []string {
+	if x != nil {
+		return x.MissingStaffIDs
+	}
+	return nil
+}
+
+// Request message for creating several staff members.
+// At most 500 staff members may be created at once.
+type BatchCreateStaffMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StaffMembers  []*StaffMember         `protobuf:"bytes,2,rep,name=staffMembers,proto3" json:"staffMembers,omitempty"`
+	Mode          BatchMode              `protobuf:"varint,3,opt,name=mode,proto3,enum=staff.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateStaffMembersRequest) Reset() {
+	*x = BatchCreateStaffMembersRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateStaffMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateStaffMembersRequest) ProtoMessage() {}
+
+func (x *BatchCreateStaffMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateStaffMembersRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateStaffMembersRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchCreateStaffMembersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BatchCreateStaffMembersRequest) GetStaffMembers() []*StaffMember {
+	if x != nil {
+		return x.StaffMembers
+	}
+	return nil
+}
+
+func (x *BatchCreateStaffMembersRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_ALL_OR_NOTHING
+}
+
+// Response message contains the result of every staff member, in the order of the request.
+type BatchCreateStaffMembersResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*BatchStaffMemberResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateStaffMembersResponse) Reset() {
+	*x = BatchCreateStaffMembersResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateStaffMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateStaffMembersResponse) ProtoMessage() {}
+
+func (x *BatchCreateStaffMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateStaffMembersResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateStaffMembersResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchCreateStaffMembersResponse) GetResults() []*BatchStaffMemberResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// A single update of a batch, with the same meaning as in UpdateStaffMemberRequest.
+type StaffMemberUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaffMember   *StaffMember           `protobuf:"bytes,1,opt,name=staffMember,proto3" json:"staffMember,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	Etag          string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffMemberUpdate) Reset() {
+	*x = StaffMemberUpdate{}
+	mi := &file_staff_microservice_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffMemberUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffMemberUpdate) ProtoMessage() {}
+
+func (x *StaffMemberUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffMemberUpdate.ProtoReflect.Descriptor instead.
+func (*StaffMemberUpdate) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StaffMemberUpdate) GetStaffMember() *StaffMember {
+	if x != nil {
+		return x.StaffMember
+	}
+	return nil
+}
+
+func (x *StaffMemberUpdate) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *StaffMemberUpdate) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// Request message for updating several staff members.
+// At most 500 staff members may be updated at once.
+type BatchUpdateStaffMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Updates       []*StaffMemberUpdate   `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
+	Mode          BatchMode              `protobuf:"varint,3,opt,name=mode,proto3,enum=staff.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateStaffMembersRequest) Reset() {
+	*x = BatchUpdateStaffMembersRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateStaffMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateStaffMembersRequest) ProtoMessage() {}
+
+func (x *BatchUpdateStaffMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateStaffMembersRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateStaffMembersRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BatchUpdateStaffMembersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BatchUpdateStaffMembersRequest) GetUpdates() []*StaffMemberUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *BatchUpdateStaffMembersRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_ALL_OR_NOTHING
+}
+
+// Response message contains the result of every update, in the order of the request.
+type BatchUpdateStaffMembersResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*BatchStaffMemberResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateStaffMembersResponse) Reset() {
+	*x = BatchUpdateStaffMembersResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateStaffMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateStaffMembersResponse) ProtoMessage() {}
+
+func (x *BatchUpdateStaffMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateStaffMembersResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateStaffMembersResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BatchUpdateStaffMembersResponse) GetResults() []*BatchStaffMemberResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// A single deletion of a batch, with the same meaning as in DeleteStaffMemberRequest.
+type StaffMemberDeletion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaffID       string                 `protobuf:"bytes,1,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffMemberDeletion) Reset() {
+	*x = StaffMemberDeletion{}
+	mi := &file_staff_microservice_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffMemberDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffMemberDeletion) ProtoMessage() {}
+
+func (x *StaffMemberDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffMemberDeletion.ProtoReflect.Descriptor instead.
+func (*StaffMemberDeletion) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StaffMemberDeletion) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+func (x *StaffMemberDeletion) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// Request message for deleting several staff members.
+// At most 500 staff members may be deleted at once.
+type BatchDeleteStaffMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Deletions     []*StaffMemberDeletion `protobuf:"bytes,2,rep,name=deletions,proto3" json:"deletions,omitempty"`
+	Mode          BatchMode              `protobuf:"varint,3,opt,name=mode,proto3,enum=staff.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteStaffMembersRequest) Reset() {
+	*x = BatchDeleteStaffMembersRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteStaffMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteStaffMembersRequest) ProtoMessage() {}
+
+func (x *BatchDeleteStaffMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteStaffMembersRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteStaffMembersRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BatchDeleteStaffMembersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BatchDeleteStaffMembersRequest) GetDeletions() []*StaffMemberDeletion {
+	if x != nil {
+		return x.Deletions
+	}
+	return nil
+}
+
+func (x *BatchDeleteStaffMembersRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_ALL_OR_NOTHING
+}
+
+// Response message contains the result of every deletion, in the order of the request.
+type BatchDeleteStaffMembersResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*BatchStaffMemberResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteStaffMembersResponse) Reset() {
+	*x = BatchDeleteStaffMembersResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteStaffMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteStaffMembersResponse) ProtoMessage() {}
+
+func (x *BatchDeleteStaffMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteStaffMembersResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteStaffMembersResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BatchDeleteStaffMembersResponse) GetResults() []*BatchStaffMemberResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// The result of a single item of a batch.
+// code is a google.rpc.Code, which is OK (0) for the items that were applied,
+// and message describes the error of the items that were not.
+// staffMember is the created or updated staff member.
+type BatchStaffMemberResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	StaffMember   *StaffMember           `protobuf:"bytes,3,opt,name=staffMember,proto3" json:"staffMember,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchStaffMemberResult) Reset() {
+	*x = BatchStaffMemberResult{}
+	mi := &file_staff_microservice_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchStaffMemberResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStaffMemberResult) ProtoMessage() {}
+
+func (x *BatchStaffMemberResult) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStaffMemberResult.ProtoReflect.Descriptor instead.
+func (*BatchStaffMemberResult) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BatchStaffMemberResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchStaffMemberResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchStaffMemberResult) GetStaffMember() *StaffMember {
+	if x != nil {
+		return x.StaffMember
+	}
+	return nil
+}
+
+// Request message for importing a roster, streamed as consecutive chunks of the file.
+// The options are read from the first message, and every message may carry the next chunk.
+// The first row of the roster is its header. columns maps headers to the StaffMember fields
+// their column holds, such as "E-mail" to "email"; other headers are matched to the field
+// of the same name, ignoring case and punctuation, and columns matching no field are ignored.
+// A row matching an existing staff member by matchBy updates the fields of the roster,
+// and any other row creates a staff member. Rows matching a deleted staff member fail with
+// its staffID, so that it can be restored. With dryRun, every row is checked and
+// reported as if it were imported, but nothing is written.
+// The roster may be up to 10 MiB and 10000 rows long.
+type ImportStaffMembersRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Token   string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Format  ImportFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=staff.ImportFormat" json:"format,omitempty"`
+	Columns map[string]string      `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MatchBy ImportMatchBy          `protobuf:"varint,4,opt,name=matchBy,proto3,enum=staff.ImportMatchBy" json:"matchBy,omitempty"`
+	DryRun  bool                   `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// Name of the XLSX sheet to import, the first sheet by default.
+	Sheet         string `protobuf:"bytes,6,opt,name=sheet,proto3" json:"sheet,omitempty"`
+	Chunk         []byte `protobuf:"bytes,7,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStaffMembersRequest) Reset() {
+	*x = ImportStaffMembersRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStaffMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStaffMembersRequest) ProtoMessage() {}
+
+func (x *ImportStaffMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStaffMembersRequest.ProtoReflect.Descriptor instead.
+func (*ImportStaffMembersRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ImportStaffMembersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ImportStaffMembersRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_CSV
+}
+
+func (x *ImportStaffMembersRequest) GetColumns() map[string]string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportStaffMembersRequest) GetMatchBy() ImportMatchBy {
+	if x != nil {
+		return x.MatchBy
+	}
+	return ImportMatchBy_IMPORT_MATCH_BY_EMAIL
+}
+
+func (x *ImportStaffMembersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportStaffMembersRequest) GetSheet() string {
+	if x != nil {
+		return x.Sheet
+	}
+	return ""
+}
+
+func (x *ImportStaffMembersRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// The outcome of a single row of the roster.
+// row is the line of the row in a CSV file or its number in the XLSX sheet, the header being row 1.
+// message describes why a row failed.
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Status        ImportRowStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=staff.ImportRowStatus" json:"status,omitempty"`
+	StaffID       string                 `protobuf:"bytes,3,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_staff_microservice_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ImportRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetStatus() ImportRowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Response message contains the outcome of every non-empty row, in the order of the roster,
+// and the number of rows of each outcome.
+type ImportStaffMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Skipped       int32                  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStaffMembersResponse) Reset() {
+	*x = ImportStaffMembersResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStaffMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStaffMembersResponse) ProtoMessage() {}
+
+func (x *ImportStaffMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStaffMembersResponse.ProtoReflect.Descriptor instead.
+func (*ImportStaffMembersResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportStaffMembersResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportStaffMembersResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportStaffMembersResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportStaffMembersResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportStaffMembersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportStaffMembersResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// Request message for listing the audit log.
+// Every filter is optional; from is inclusive and to is exclusive.
+// pageToken is the opaque nextPageToken returned by a previous call.
+type ListStaffAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StaffID       string                 `protobuf:"bytes,2,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,7,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffAuditEventsRequest) Reset() {
+	*x = ListStaffAuditEventsRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListStaffAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListStaffAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListStaffAuditEventsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListStaffAuditEventsRequest) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+func (x *ListStaffAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListStaffAuditEventsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListStaffAuditEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListStaffAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStaffAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message contains a page of audit events, newest first.
+// nextPageToken is empty when there are no more pages.
+type ListStaffAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*StaffAuditEvent     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffAuditEventsResponse) Reset() {
+	*x = ListStaffAuditEventsResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListStaffAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListStaffAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListStaffAuditEventsResponse) GetEvents() []*StaffAuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListStaffAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// A single change to a staff member.
+// before and after hold the StaffMember fields the change modified, by field name,
+// with their values before and after the change.
+type StaffAuditEvent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventID int64                  `protobuf:"varint,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	StaffID string                 `protobuf:"bytes,2,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	Action  StaffAuditAction       `protobuf:"varint,3,opt,name=action,proto3,enum=staff.StaffAuditAction" json:"action,omitempty"`
+	// Full name of the RPC that made the change.
+	Rpc string `protobuf:"bytes,4,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	// Token subject of whoever made the change.
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The x-request-id metadata of the request, or an ID assigned by the server.
+	RequestID     string                 `protobuf:"bytes,6,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Before        map[string]string      `protobuf:"bytes,7,rep,name=before,proto3" json:"before,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	After         map[string]string      `protobuf:"bytes,8,rep,name=after,proto3" json:"after,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffAuditEvent) Reset() {
+	*x = StaffAuditEvent{}
+	mi := &file_staff_microservice_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffAuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffAuditEvent) ProtoMessage() {}
+
+func (x *StaffAuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffAuditEvent.ProtoReflect.Descriptor instead.
+func (*StaffAuditEvent) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StaffAuditEvent) GetEventID() int64 {
+	if x != nil {
+		return x.EventID
+	}
+	return 0
+}
+
+func (x *StaffAuditEvent) GetStaffID() string {
+	if x != nil {
+		return x.StaffID
+	}
+	return ""
+}
+
+func (x *StaffAuditEvent) GetAction() StaffAuditAction {
+	if x != nil {
+		return x.Action
+	}
+	return StaffAuditAction_AUDIT_ACTION_UNSPECIFIED
+}
+
+func (x *StaffAuditEvent) GetRpc() string {
+	if x != nil {
+		return x.Rpc
+	}
+	return ""
+}
+
+func (x *StaffAuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StaffAuditEvent) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *StaffAuditEvent) GetBefore() map[string]string {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *StaffAuditEvent) GetAfter() map[string]string {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *StaffAuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// An event announcing a committed change to a staff member, published on the
+// staff.created, staff.updated and staff.deleted subjects.
+// Events are delivered at least once, and in order for each staff member; the message ID identifies
+// the event so consumers can ignore redeliveries.
+type StaffEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  StaffEventType         `protobuf:"varint,1,opt,name=type,proto3,enum=staff.StaffEventType" json:"type,omitempty"`
+	// The staff member after the change.
+	StaffMember   *StaffMember           `protobuf:"bytes,2,opt,name=staffMember,proto3" json:"staffMember,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffEvent) Reset() {
+	*x = StaffEvent{}
+	mi := &file_staff_microservice_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffEvent) ProtoMessage() {}
+
+func (x *StaffEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffEvent.ProtoReflect.Descriptor instead.
+func (*StaffEvent) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StaffEvent) GetType() StaffEventType {
+	if x != nil {
+		return x.Type
+	}
+	return StaffEventType_STAFF_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *StaffEvent) GetStaffMember() *StaffMember {
+	if x != nil {
+		return x.StaffMember
+	}
+	return nil
+}
+
+func (x *StaffEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Request message for watching staff members.
+// Only changes to the listed staffIDs, or to staff members in the listed offices
+// after the change, are streamed; empty lists match every staff member.
+// resumeRevision is the revision of the last change the client received, to resume
+// the stream after it; without it, only changes committed from now on are streamed.
+type WatchStaffMembersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StaffIDs       []string               `protobuf:"bytes,2,rep,name=staffIDs,proto3" json:"staffIDs,omitempty"`
+	Offices        []string               `protobuf:"bytes,3,rep,name=offices,proto3" json:"offices,omitempty"`
+	ResumeRevision string                 `protobuf:"bytes,4,opt,name=resumeRevision,proto3" json:"resumeRevision,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WatchStaffMembersRequest) Reset() {
+	*x = WatchStaffMembersRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchStaffMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStaffMembersRequest) ProtoMessage() {}
+
+func (x *WatchStaffMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStaffMembersRequest.ProtoReflect.Descriptor instead.
+func (*WatchStaffMembersRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WatchStaffMembersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *WatchStaffMembersRequest) GetStaffIDs() []string {
+	if x != nil {
+		return x.StaffIDs
+	}
+	return nil
+}
+
+func (x *WatchStaffMembersRequest) GetOffices() []string {
+	if x != nil {
+		return x.Offices
+	}
+	return nil
+}
+
+func (x *WatchStaffMembersRequest) GetResumeRevision() string {
+	if x != nil {
+		return x.ResumeRevision
+	}
+	return ""
+}
+
+// Response message contains a single change, in the same order on every replica.
+// revision is an opaque token identifying the change.
+type WatchStaffMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *StaffEvent            `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Revision      string                 `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchStaffMembersResponse) Reset() {
+	*x = WatchStaffMembersResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteStaffMemberResponse) String() string {
+func (x *WatchStaffMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteStaffMemberResponse) ProtoMessage() {}
+func (*WatchStaffMembersResponse) ProtoMessage() {}
 
-func (x *DeleteStaffMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_staff_microservice_proto_msgTypes[7]
+func (x *WatchStaffMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,28 +2909,178 @@ func (x *DeleteStaffMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteStaffMemberResponse.ProtoReflect.Descriptor instead.
-func (*DeleteStaffMemberResponse) Descriptor() ([]byte, []int) {
-	return file_staff_microservice_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use WatchStaffMembersResponse.ProtoReflect.Descriptor instead.
+func (*WatchStaffMembersResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WatchStaffMembersResponse) GetEvent() *StaffEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchStaffMembersResponse) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+// Request message for exporting the staff directory.
+// The filters have the same meaning as in ListStaffMembersRequest, and staff members are
+// exported by last name. includeDeleted is allowed for admins only.
+type ExportStaffMembersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Format         ExportFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=staff.ExportFormat" json:"format,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Office         string                 `protobuf:"bytes,4,opt,name=office,proto3" json:"office,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=includeDeleted,proto3" json:"includeDeleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportStaffMembersRequest) Reset() {
+	*x = ExportStaffMembersRequest{}
+	mi := &file_staff_microservice_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStaffMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStaffMembersRequest) ProtoMessage() {}
+
+func (x *ExportStaffMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStaffMembersRequest.ProtoReflect.Descriptor instead.
+func (*ExportStaffMembersRequest) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExportStaffMembersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExportStaffMembersRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_CSV
+}
+
+func (x *ExportStaffMembersRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ExportStaffMembersRequest) GetOffice() string {
+	if x != nil {
+		return x.Office
+	}
+	return ""
+}
+
+func (x *ExportStaffMembersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// Response message contains the next chunk of the exported file.
+type ExportStaffMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStaffMembersResponse) Reset() {
+	*x = ExportStaffMembersResponse{}
+	mi := &file_staff_microservice_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStaffMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStaffMembersResponse) ProtoMessage() {}
+
+func (x *ExportStaffMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_staff_microservice_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStaffMembersResponse.ProtoReflect.Descriptor instead.
+func (*ExportStaffMembersResponse) Descriptor() ([]byte, []int) {
+	return file_staff_microservice_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExportStaffMembersResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
 }
 
 // StaffMember message includes:
 type StaffMember struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StaffID       string                 `protobuf:"bytes,1,opt,name=staffID,proto3" json:"staffID,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=firstName,proto3" json:"firstName,omitempty"`
-	LastName      string                 `protobuf:"bytes,3,opt,name=lastName,proto3" json:"lastName,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
-	Office        string                 `protobuf:"bytes,7,opt,name=office,proto3" json:"office,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	StaffID     string                 `protobuf:"bytes,1,opt,name=staffID,proto3" json:"staffID,omitempty"`
+	FirstName   string                 `protobuf:"bytes,2,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	LastName    string                 `protobuf:"bytes,3,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	Email       string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber string                 `protobuf:"bytes,5,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Title       string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Office      string                 `protobuf:"bytes,7,opt,name=office,proto3" json:"office,omitempty"`
+	// Set only for deleted staff members.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	// Changes on every write to the staff member.
+	Etag      string                 `protobuf:"bytes,9,opt,name=etag,proto3" json:"etag,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// Token subjects of whoever created and last changed the staff member.
+	CreatedBy string `protobuf:"bytes,12,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	UpdatedBy string `protobuf:"bytes,13,opt,name=updatedBy,proto3" json:"updatedBy,omitempty"`
+	// Token subject of the staff member's own account, which lets them update their own record.
+	// Only admins may set it, and importers on creation.
+	Subject       string `protobuf:"bytes,14,opt,name=subject,proto3" json:"subject,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StaffMember) Reset() {
 	*x = StaffMember{}
-	mi := &file_staff_microservice_proto_msgTypes[8]
+	mi := &file_staff_microservice_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +3092,7 @@ func (x *StaffMember) String() string {
 func (*StaffMember) ProtoMessage() {}
 
 func (x *StaffMember) ProtoReflect() protoreflect.Message {
-	mi := &file_staff_microservice_proto_msgTypes[8]
+	mi := &file_staff_microservice_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +3105,7 @@ func (x *StaffMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaffMember.ProtoReflect.Descriptor instead.
 func (*StaffMember) Descriptor() ([]byte, []int) {
-	return file_staff_microservice_proto_rawDescGZIP(), []int{8}
+	return file_staff_microservice_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *StaffMember) GetStaffID() string {
@@ -497,90 +3157,610 @@ func (x *StaffMember) GetOffice() string {
 	return ""
 }
 
+func (x *StaffMember) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *StaffMember) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *StaffMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StaffMember) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *StaffMember) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *StaffMember) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *StaffMember) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
 var File_staff_microservice_proto protoreflect.FileDescriptor
 
 var file_staff_microservice_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x73, 0x74, 0x61, 0x66,
-	0x66, 0x22, 0x47, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x22, 0x4e, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66,
-	0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x73,
-	0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x18, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x0b,
-	0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x22, 0x51, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66,
 	0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x34, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61,
 	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
 	0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66,
 	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
 	0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
 	0x52, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x51, 0x0a,
-	0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
+	0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
 	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x74,
 	0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
 	0x62, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x22, 0x4a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x22, 0xb6, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x51, 0x0a, 0x19, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xa3, 0x01, 0x0a,
+	0x18, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x34, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x0a, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x22, 0x7e, 0x0a, 0x19, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x55, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x5e, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74,
+	0x61, 0x67, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4b, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
 	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
 	0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x22, 0x1b, 0x0a, 0x19,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x22, 0x52, 0x0a, 0x1a,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x49, 0x0a, 0x17, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x18, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x94, 0x02, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x69, 0x63,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x98,
+	0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x19, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x56, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0x65, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x77, 0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x22, 0x80, 0x01, 0x0a, 0x1c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0c, 0x73, 0x74, 0x61,
+	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x49, 0x44, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x5a, 0x0a, 0x1f, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74,
+	0x61, 0x67, 0x22, 0x90, 0x01, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x24, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x5a, 0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x43, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x96, 0x01, 0x0a, 0x1e, 0x42, 0x61, 0x74, 0x63, 0x68,
 	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x0b, 0x53, 0x74,
-	0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61,
-	0x66, 0x66, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66,
-	0x66, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f,
-	0x66, 0x66, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x66, 0x66,
-	0x69, 0x63, 0x65, 0x32, 0xe5, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
-	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x61, 0x66,
-	0x66, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x1f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61,
-	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x61, 0x66,
-	0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72,
-	0x47, 0x52, 0x2f, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x38, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22,
+	0x5a, 0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x16, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xd7, 0x02, 0x0a, 0x19, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x47, 0x0a, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x79, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x65, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f,
+	0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61,
+	0x66, 0x66, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc6, 0x01,
+	0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x61,
+	0x66, 0x66, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xf9, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x66, 0x66, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x74, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe0, 0x03, 0x0a, 0x0f, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44,
+	0x12, 0x2f, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x70, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x72, 0x70, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x38, 0x0a, 0x0a, 0x41, 0x66, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa9, 0x01, 0x0a, 0x0a,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61,
+	0x66, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x6f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x18, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x49, 0x44, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x19, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb4, 0x01, 0x0a, 0x19, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x22, 0x32, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0xdf, 0x03, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x69, 0x63,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x12,
+	0x38, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61,
+	0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2a, 0x43, 0x0a, 0x14, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x12, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54, 0x5f, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x46, 0x46, 0x5f, 0x49, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x50, 0x53, 0x45, 0x52,
+	0x54, 0x5f, 0x4f, 0x4e, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x2a, 0x80, 0x01, 0x0a,
+	0x0c, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a,
+	0x19, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x55, 0x50, 0x53, 0x45, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x50, 0x53, 0x45, 0x52,
+	0x54, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53,
+	0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0x5f, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42,
+	0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x4c, 0x41, 0x53, 0x54,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x42, 0x59, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x02,
+	0x2a, 0x46, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
+	0x19, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f,
+	0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x5f,
+	0x45, 0x46, 0x46, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x2a, 0x3d, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4d, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x58, 0x4c, 0x53, 0x58, 0x10, 0x01, 0x2a, 0x48, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x79, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4d, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x42, 0x59, 0x5f, 0x45, 0x4d, 0x41, 0x49,
+	0x4c, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x46, 0x46, 0x5f, 0x49, 0x44, 0x10,
+	0x01, 0x2a, 0x93, 0x01, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x52, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x15, 0x0a, 0x11, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xad, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18,
+	0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55,
+	0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13,
+	0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x04, 0x12,
+	0x16, 0x0a, 0x12, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x55, 0x52, 0x47, 0x45, 0x10, 0x05, 0x2a, 0x6b, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x41,
+	0x46, 0x46, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53,
+	0x54, 0x41, 0x46, 0x46, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x53, 0x54, 0x41, 0x46, 0x46, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x46, 0x46, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x2a, 0x58, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e, 0x44, 0x4a,
+	0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x56, 0x43, 0x41, 0x52, 0x44, 0x10, 0x02, 0x32, 0xb1,
+	0x0c, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x66, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x4d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x11, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x55, 0x70, 0x73,
+	0x65, 0x72, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59,
+	0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x2e,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x61,
+	0x66, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x58, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x5f, 0x0a, 0x14, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x17, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x25, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
+	0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20,
+	0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x5b, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x74, 0x61, 0x66, 0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x66, 0x66, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x52, 0x2f, 0x73, 0x74, 0x61, 0x66, 0x66, 0x2d,
+	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -595,37 +3775,155 @@ func file_staff_microservice_proto_rawDescGZIP() []byte {
 	return file_staff_microservice_proto_rawDescData
 }
 
-var file_staff_microservice_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_staff_microservice_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_staff_microservice_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_staff_microservice_proto_goTypes = []any{
-	(*GetStaffMemberRequest)(nil),     // 0: staff.GetStaffMemberRequest
-	(*GetStaffMemberResponse)(nil),    // 1: staff.GetStaffMemberResponse
-	(*CreateStaffMemberRequest)(nil),  // 2: staff.CreateStaffMemberRequest
-	(*CreateStaffMemberResponse)(nil), // 3: staff.CreateStaffMemberResponse
-	(*UpdateStaffMemberRequest)(nil),  // 4: staff.UpdateStaffMemberRequest
-	(*UpdateStaffMemberResponse)(nil), // 5: staff.UpdateStaffMemberResponse
-	(*DeleteStaffMemberRequest)(nil),  // 6: staff.DeleteStaffMemberRequest
-	(*DeleteStaffMemberResponse)(nil), // 7: staff.DeleteStaffMemberResponse
-	(*StaffMember)(nil),               // 8: staff.StaffMember
+	(UpsertConflictTarget)(0),               // 0: staff.UpsertConflictTarget
+	(UpsertResult)(0),                       // 1: staff.UpsertResult
+	(StaffMemberOrderBy)(0),                 // 2: staff.StaffMemberOrderBy
+	(BatchMode)(0),                          // 3: staff.BatchMode
+	(ImportFormat)(0),                       // 4: staff.ImportFormat
+	(ImportMatchBy)(0),                      // 5: staff.ImportMatchBy
+	(ImportRowStatus)(0),                    // 6: staff.ImportRowStatus
+	(StaffAuditAction)(0),                   // 7: staff.StaffAuditAction
+	(StaffEventType)(0),                     // 8: staff.StaffEventType
+	(ExportFormat)(0),                       // 9: staff.ExportFormat
+	(*GetStaffMemberRequest)(nil),           // 10: staff.GetStaffMemberRequest
+	(*GetStaffMemberResponse)(nil),          // 11: staff.GetStaffMemberResponse
+	(*CreateStaffMemberRequest)(nil),        // 12: staff.CreateStaffMemberRequest
+	(*CreateStaffMemberResponse)(nil),       // 13: staff.CreateStaffMemberResponse
+	(*UpdateStaffMemberRequest)(nil),        // 14: staff.UpdateStaffMemberRequest
+	(*UpdateStaffMemberResponse)(nil),       // 15: staff.UpdateStaffMemberResponse
+	(*UpsertStaffMemberRequest)(nil),        // 16: staff.UpsertStaffMemberRequest
+	(*UpsertStaffMemberResponse)(nil),       // 17: staff.UpsertStaffMemberResponse
+	(*DeleteStaffMemberRequest)(nil),        // 18: staff.DeleteStaffMemberRequest
+	(*DeleteStaffMemberResponse)(nil),       // 19: staff.DeleteStaffMemberResponse
+	(*RestoreStaffMemberRequest)(nil),       // 20: staff.RestoreStaffMemberRequest
+	(*RestoreStaffMemberResponse)(nil),      // 21: staff.RestoreStaffMemberResponse
+	(*PurgeStaffMemberRequest)(nil),         // 22: staff.PurgeStaffMemberRequest
+	(*PurgeStaffMemberResponse)(nil),        // 23: staff.PurgeStaffMemberResponse
+	(*ListStaffMembersRequest)(nil),         // 24: staff.ListStaffMembersRequest
+	(*ListStaffMembersResponse)(nil),        // 25: staff.ListStaffMembersResponse
+	(*SearchStaffMembersRequest)(nil),       // 26: staff.SearchStaffMembersRequest
+	(*SearchStaffMembersResponse)(nil),      // 27: staff.SearchStaffMembersResponse
+	(*StaffMemberSearchResult)(nil),         // 28: staff.StaffMemberSearchResult
+	(*BatchGetStaffMembersRequest)(nil),     // 29: staff.BatchGetStaffMembersRequest
+	(*BatchGetStaffMembersResponse)(nil),    // 30: staff.BatchGetStaffMembersResponse
+	(*BatchCreateStaffMembersRequest)(nil),  // 31: staff.BatchCreateStaffMembersRequest
+	(*BatchCreateStaffMembersResponse)(nil), // 32: staff.BatchCreateStaffMembersResponse
+	(*StaffMemberUpdate)(nil),               // 33: staff.StaffMemberUpdate
+	(*BatchUpdateStaffMembersRequest)(nil),  // 34: staff.BatchUpdateStaffMembersRequest
+	(*BatchUpdateStaffMembersResponse)(nil), // 35: staff.BatchUpdateStaffMembersResponse
+	(*StaffMemberDeletion)(nil),             // 36: staff.StaffMemberDeletion
+	(*BatchDeleteStaffMembersRequest)(nil),  // 37: staff.BatchDeleteStaffMembersRequest
+	(*BatchDeleteStaffMembersResponse)(nil), // 38: staff.BatchDeleteStaffMembersResponse
+	(*BatchStaffMemberResult)(nil),          // 39: staff.BatchStaffMemberResult
+	(*ImportStaffMembersRequest)(nil),       // 40: staff.ImportStaffMembersRequest
+	(*ImportRowResult)(nil),                 // 41: staff.ImportRowResult
+	(*ImportStaffMembersResponse)(nil),      // 42: staff.ImportStaffMembersResponse
+	(*ListStaffAuditEventsRequest)(nil),     // 43: staff.ListStaffAuditEventsRequest
+	(*ListStaffAuditEventsResponse)(nil),    // 44: staff.ListStaffAuditEventsResponse
+	(*StaffAuditEvent)(nil),                 // 45: staff.StaffAuditEvent
+	(*StaffEvent)(nil),                      // 46: staff.StaffEvent
+	(*WatchStaffMembersRequest)(nil),        // 47: staff.WatchStaffMembersRequest
+	(*WatchStaffMembersResponse)(nil),       // 48: staff.WatchStaffMembersResponse
+	(*ExportStaffMembersRequest)(nil),       // 49: staff.ExportStaffMembersRequest
+	(*ExportStaffMembersResponse)(nil),      // 50: staff.ExportStaffMembersResponse
+	(*StaffMember)(nil),                     // 51: staff.StaffMember
+	nil,                                     // 52: staff.ImportStaffMembersRequest.ColumnsEntry
+	nil,                                     // 53: staff.StaffAuditEvent.BeforeEntry
+	nil,                                     // 54: staff.StaffAuditEvent.AfterEntry
+	(*fieldmaskpb.FieldMask)(nil),           // 55: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),           // 56: google.protobuf.Timestamp
 }
 var file_staff_microservice_proto_depIdxs = []int32{
-	8, // 0: staff.GetStaffMemberResponse.staffMember:type_name -> staff.StaffMember
-	8, // 1: staff.CreateStaffMemberRequest.staffMember:type_name -> staff.StaffMember
-	8, // 2: staff.CreateStaffMemberResponse.staffMember:type_name -> staff.StaffMember
-	8, // 3: staff.UpdateStaffMemberRequest.staffMember:type_name -> staff.StaffMember
-	8, // 4: staff.UpdateStaffMemberResponse.staffMember:type_name -> staff.StaffMember
-	0, // 5: staff.StaffService.GetStaffMember:input_type -> staff.GetStaffMemberRequest
-	2, // 6: staff.StaffService.CreateStaffMember:input_type -> staff.CreateStaffMemberRequest
-	4, // 7: staff.StaffService.UpdateStaffMember:input_type -> staff.UpdateStaffMemberRequest
-	6, // 8: staff.StaffService.DeleteStaffMember:input_type -> staff.DeleteStaffMemberRequest
-	1, // 9: staff.StaffService.GetStaffMember:output_type -> staff.GetStaffMemberResponse
-	3, // 10: staff.StaffService.CreateStaffMember:output_type -> staff.CreateStaffMemberResponse
-	5, // 11: staff.StaffService.UpdateStaffMember:output_type -> staff.UpdateStaffMemberResponse
-	7, // 12: staff.StaffService.DeleteStaffMember:output_type -> staff.DeleteStaffMemberResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	51, // 0: staff.GetStaffMemberResponse.staffMember:type_name -> staff.StaffMember
+	51, // 1: staff.CreateStaffMemberRequest.staffMember:type_name -> staff.StaffMember
+	51, // 2: staff.CreateStaffMemberResponse.staffMember:type_name -> staff.StaffMember
+	51, // 3: staff.UpdateStaffMemberRequest.staffMember:type_name -> staff.StaffMember
+	55, // 4: staff.UpdateStaffMemberRequest.updateMask:type_name -> google.protobuf.FieldMask
+	51, // 5: staff.UpdateStaffMemberResponse.staffMember:type_name -> staff.StaffMember
+	51, // 6: staff.UpsertStaffMemberRequest.staffMember:type_name -> staff.StaffMember
+	0,  // 7: staff.UpsertStaffMemberRequest.onConflict:type_name -> staff.UpsertConflictTarget
+	51, // 8: staff.UpsertStaffMemberResponse.staffMember:type_name -> staff.StaffMember
+	1,  // 9: staff.UpsertStaffMemberResponse.result:type_name -> staff.UpsertResult
+	51, // 10: staff.RestoreStaffMemberResponse.staffMember:type_name -> staff.StaffMember
+	2,  // 11: staff.ListStaffMembersRequest.orderBy:type_name -> staff.StaffMemberOrderBy
+	51, // 12: staff.ListStaffMembersResponse.staffMembers:type_name -> staff.StaffMember
+	28, // 13: staff.SearchStaffMembersResponse.results:type_name -> staff.StaffMemberSearchResult
+	51, // 14: staff.StaffMemberSearchResult.staffMember:type_name -> staff.StaffMember
+	51, // 15: staff.BatchGetStaffMembersResponse.staffMembers:type_name -> staff.StaffMember
+	51, // 16: staff.BatchCreateStaffMembersRequest.staffMembers:type_name -> staff.StaffMember
+	3,  // 17: staff.BatchCreateStaffMembersRequest.mode:type_name -> staff.BatchMode
+	39, // 18: staff.BatchCreateStaffMembersResponse.results:type_name -> staff.BatchStaffMemberResult
+	51, // 19: staff.StaffMemberUpdate.staffMember:type_name -> staff.StaffMember
+	55, // 20: staff.StaffMemberUpdate.updateMask:type_name -> google.protobuf.FieldMask
+	33, // 21: staff.BatchUpdateStaffMembersRequest.updates:type_name -> staff.StaffMemberUpdate
+	3,  // 22: staff.BatchUpdateStaffMembersRequest.mode:type_name -> staff.BatchMode
+	39, // 23: staff.BatchUpdateStaffMembersResponse.results:type_name -> staff.BatchStaffMemberResult
+	36, // 24: staff.BatchDeleteStaffMembersRequest.deletions:type_name -> staff.StaffMemberDeletion
+	3,  // 25: staff.BatchDeleteStaffMembersRequest.mode:type_name -> staff.BatchMode
+	39, // 26: staff.BatchDeleteStaffMembersResponse.results:type_name -> staff.BatchStaffMemberResult
+	51, // 27: staff.BatchStaffMemberResult.staffMember:type_name -> staff.StaffMember
+	4,  // 28: staff.ImportStaffMembersRequest.format:type_name -> staff.ImportFormat
+	52, // 29: staff.ImportStaffMembersRequest.columns:type_name -> staff.ImportStaffMembersRequest.ColumnsEntry
+	5,  // 30: staff.ImportStaffMembersRequest.matchBy:type_name -> staff.ImportMatchBy
+	6,  // 31: staff.ImportRowResult.status:type_name -> staff.ImportRowStatus
+	41, // 32: staff.ImportStaffMembersResponse.rows:type_name -> staff.ImportRowResult
+	56, // 33: staff.ListStaffAuditEventsRequest.from:type_name -> google.protobuf.Timestamp
+	56, // 34: staff.ListStaffAuditEventsRequest.to:type_name -> google.protobuf.Timestamp
+	45, // 35: staff.ListStaffAuditEventsResponse.events:type_name -> staff.StaffAuditEvent
+	7,  // 36: staff.StaffAuditEvent.action:type_name -> staff.StaffAuditAction
+	53, // 37: staff.StaffAuditEvent.before:type_name -> staff.StaffAuditEvent.BeforeEntry
+	54, // 38: staff.StaffAuditEvent.after:type_name -> staff.StaffAuditEvent.AfterEntry
+	56, // 39: staff.StaffAuditEvent.createdAt:type_name -> google.protobuf.Timestamp
+	8,  // 40: staff.StaffEvent.type:type_name -> staff.StaffEventType
+	51, // 41: staff.StaffEvent.staffMember:type_name -> staff.StaffMember
+	56, // 42: staff.StaffEvent.occurredAt:type_name -> google.protobuf.Timestamp
+	46, // 43: staff.WatchStaffMembersResponse.event:type_name -> staff.StaffEvent
+	9,  // 44: staff.ExportStaffMembersRequest.format:type_name -> staff.ExportFormat
+	56, // 45: staff.StaffMember.deletedAt:type_name -> google.protobuf.Timestamp
+	56, // 46: staff.StaffMember.createdAt:type_name -> google.protobuf.Timestamp
+	56, // 47: staff.StaffMember.updatedAt:type_name -> google.protobuf.Timestamp
+	10, // 48: staff.StaffService.GetStaffMember:input_type -> staff.GetStaffMemberRequest
+	12, // 49: staff.StaffService.CreateStaffMember:input_type -> staff.CreateStaffMemberRequest
+	14, // 50: staff.StaffService.UpdateStaffMember:input_type -> staff.UpdateStaffMemberRequest
+	16, // 51: staff.StaffService.UpsertStaffMember:input_type -> staff.UpsertStaffMemberRequest
+	18, // 52: staff.StaffService.DeleteStaffMember:input_type -> staff.DeleteStaffMemberRequest
+	24, // 53: staff.StaffService.ListStaffMembers:input_type -> staff.ListStaffMembersRequest
+	26, // 54: staff.StaffService.SearchStaffMembers:input_type -> staff.SearchStaffMembersRequest
+	20, // 55: staff.StaffService.RestoreStaffMember:input_type -> staff.RestoreStaffMemberRequest
+	22, // 56: staff.StaffService.PurgeStaffMember:input_type -> staff.PurgeStaffMemberRequest
+	43, // 57: staff.StaffService.ListStaffAuditEvents:input_type -> staff.ListStaffAuditEventsRequest
+	47, // 58: staff.StaffService.WatchStaffMembers:input_type -> staff.WatchStaffMembersRequest
+	29, // 59: staff.StaffService.BatchGetStaffMembers:input_type -> staff.BatchGetStaffMembersRequest
+	31, // 60: staff.StaffService.BatchCreateStaffMembers:input_type -> staff.BatchCreateStaffMembersRequest
+	34, // 61: staff.StaffService.BatchUpdateStaffMembers:input_type -> staff.BatchUpdateStaffMembersRequest
+	37, // 62: staff.StaffService.BatchDeleteStaffMembers:input_type -> staff.BatchDeleteStaffMembersRequest
+	40, // 63: staff.StaffService.ImportStaffMembers:input_type -> staff.ImportStaffMembersRequest
+	49, // 64: staff.StaffService.ExportStaffMembers:input_type -> staff.ExportStaffMembersRequest
+	11, // 65: staff.StaffService.GetStaffMember:output_type -> staff.GetStaffMemberResponse
+	13, // 66: staff.StaffService.CreateStaffMember:output_type -> staff.CreateStaffMemberResponse
+	15, // 67: staff.StaffService.UpdateStaffMember:output_type -> staff.UpdateStaffMemberResponse
+	17, // 68: staff.StaffService.UpsertStaffMember:output_type -> staff.UpsertStaffMemberResponse
+	19, // 69: staff.StaffService.DeleteStaffMember:output_type -> staff.DeleteStaffMemberResponse
+	25, // 70: staff.StaffService.ListStaffMembers:output_type -> staff.ListStaffMembersResponse
+	27, // 71: staff.StaffService.SearchStaffMembers:output_type -> staff.SearchStaffMembersResponse
+	21, // 72: staff.StaffService.RestoreStaffMember:output_type -> staff.RestoreStaffMemberResponse
+	23, // 73: staff.StaffService.PurgeStaffMember:output_type -> staff.PurgeStaffMemberResponse
+	44, // 74: staff.StaffService.ListStaffAuditEvents:output_type -> staff.ListStaffAuditEventsResponse
+	48, // 75: staff.StaffService.WatchStaffMembers:output_type -> staff.WatchStaffMembersResponse
+	30, // 76: staff.StaffService.BatchGetStaffMembers:output_type -> staff.BatchGetStaffMembersResponse
+	32, // 77: staff.StaffService.BatchCreateStaffMembers:output_type -> staff.BatchCreateStaffMembersResponse
+	35, // 78: staff.StaffService.BatchUpdateStaffMembers:output_type -> staff.BatchUpdateStaffMembersResponse
+	38, // 79: staff.StaffService.BatchDeleteStaffMembers:output_type -> staff.BatchDeleteStaffMembersResponse
+	42, // 80: staff.StaffService.ImportStaffMembers:output_type -> staff.ImportStaffMembersResponse
+	50, // 81: staff.StaffService.ExportStaffMembers:output_type -> staff.ExportStaffMembersResponse
+	65, // [65:82] is the sub-list for method output_type
+	48, // [48:65] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_staff_microservice_proto_init() }
@@ -638,13 +3936,14 @@ func file_staff_microservice_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_staff_microservice_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      10,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_staff_microservice_proto_goTypes,
 		DependencyIndexes: file_staff_microservice_proto_depIdxs,
+		EnumInfos:         file_staff_microservice_proto_enumTypes,
 		MessageInfos:      file_staff_microservice_proto_msgTypes,
 	}.Build()
 	File_staff_microservice_proto = out.File
@@ -19,10 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StaffService_GetStaffMember_FullMethodName    = "/staff.StaffService/GetStaffMember"
-	StaffService_CreateStaffMember_FullMethodName = "/staff.StaffService/CreateStaffMember"
-	StaffService_UpdateStaffMember_FullMethodName = "/staff.StaffService/UpdateStaffMember"
-	StaffService_DeleteStaffMember_FullMethodName = "/staff.StaffService/DeleteStaffMember"
+	StaffService_GetStaffMember_FullMethodName          = "/staff.StaffService/GetStaffMember"
+	StaffService_CreateStaffMember_FullMethodName       = "/staff.StaffService/CreateStaffMember"
+	StaffService_UpdateStaffMember_FullMethodName       = "/staff.StaffService/UpdateStaffMember"
+	StaffService_UpsertStaffMember_FullMethodName       = "/staff.StaffService/UpsertStaffMember"
+	StaffService_DeleteStaffMember_FullMethodName       = "/staff.StaffService/DeleteStaffMember"
+	StaffService_ListStaffMembers_FullMethodName        = "/staff.StaffService/ListStaffMembers"
+	StaffService_SearchStaffMembers_FullMethodName      = "/staff.StaffService/SearchStaffMembers"
+	StaffService_RestoreStaffMember_FullMethodName      = "/staff.StaffService/RestoreStaffMember"
+	StaffService_PurgeStaffMember_FullMethodName        = "/staff.StaffService/PurgeStaffMember"
+	StaffService_ListStaffAuditEvents_FullMethodName    = "/staff.StaffService/ListStaffAuditEvents"
+	StaffService_WatchStaffMembers_FullMethodName       = "/staff.StaffService/WatchStaffMembers"
+	StaffService_BatchGetStaffMembers_FullMethodName    = "/staff.StaffService/BatchGetStaffMembers"
+	StaffService_BatchCreateStaffMembers_FullMethodName = "/staff.StaffService/BatchCreateStaffMembers"
+	StaffService_BatchUpdateStaffMembers_FullMethodName = "/staff.StaffService/BatchUpdateStaffMembers"
+	StaffService_BatchDeleteStaffMembers_FullMethodName = "/staff.StaffService/BatchDeleteStaffMembers"
+	StaffService_ImportStaffMembers_FullMethodName      = "/staff.StaffService/ImportStaffMembers"
+	StaffService_ExportStaffMembers_FullMethodName      = "/staff.StaffService/ExportStaffMembers"
 )
 
 // StaffServiceClient is the client API for StaffService service.
@@ -35,8 +48,34 @@ type StaffServiceClient interface {
 	CreateStaffMember(ctx context.Context, in *CreateStaffMemberRequest, opts ...grpc.CallOption) (*CreateStaffMemberResponse, error)
 	// Update a staff member
 	UpdateStaffMember(ctx context.Context, in *UpdateStaffMemberRequest, opts ...grpc.CallOption) (*UpdateStaffMemberResponse, error)
+	// Create a staff member, or update the staff member with the same staffID or email
+	UpsertStaffMember(ctx context.Context, in *UpsertStaffMemberRequest, opts ...grpc.CallOption) (*UpsertStaffMemberResponse, error)
 	// Delete a staff member
 	DeleteStaffMember(ctx context.Context, in *DeleteStaffMemberRequest, opts ...grpc.CallOption) (*DeleteStaffMemberResponse, error)
+	// List staff members page by page
+	ListStaffMembers(ctx context.Context, in *ListStaffMembersRequest, opts ...grpc.CallOption) (*ListStaffMembersResponse, error)
+	// Search staff members by name, email or office
+	SearchStaffMembers(ctx context.Context, in *SearchStaffMembersRequest, opts ...grpc.CallOption) (*SearchStaffMembersResponse, error)
+	// Restore a deleted staff member
+	RestoreStaffMember(ctx context.Context, in *RestoreStaffMemberRequest, opts ...grpc.CallOption) (*RestoreStaffMemberResponse, error)
+	// Permanently remove a deleted staff member
+	PurgeStaffMember(ctx context.Context, in *PurgeStaffMemberRequest, opts ...grpc.CallOption) (*PurgeStaffMemberResponse, error)
+	// List the audit log of changes to staff members, newest first
+	ListStaffAuditEvents(ctx context.Context, in *ListStaffAuditEventsRequest, opts ...grpc.CallOption) (*ListStaffAuditEventsResponse, error)
+	// Stream the changes to staff members as they are committed
+	WatchStaffMembers(ctx context.Context, in *WatchStaffMembersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchStaffMembersResponse], error)
+	// Get several staff members by ID
+	BatchGetStaffMembers(ctx context.Context, in *BatchGetStaffMembersRequest, opts ...grpc.CallOption) (*BatchGetStaffMembersResponse, error)
+	// Create several staff members in a single transaction
+	BatchCreateStaffMembers(ctx context.Context, in *BatchCreateStaffMembersRequest, opts ...grpc.CallOption) (*BatchCreateStaffMembersResponse, error)
+	// Update several staff members in a single transaction
+	BatchUpdateStaffMembers(ctx context.Context, in *BatchUpdateStaffMembersRequest, opts ...grpc.CallOption) (*BatchUpdateStaffMembersResponse, error)
+	// Delete several staff members in a single transaction
+	BatchDeleteStaffMembers(ctx context.Context, in *BatchDeleteStaffMembersRequest, opts ...grpc.CallOption) (*BatchDeleteStaffMembersResponse, error)
+	// Import a roster of staff members from a CSV or XLSX file sent in chunks
+	ImportStaffMembers(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportStaffMembersRequest, ImportStaffMembersResponse], error)
+	// Export the staff directory as a CSV, NDJSON or vCard file streamed in chunks
+	ExportStaffMembers(ctx context.Context, in *ExportStaffMembersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportStaffMembersResponse], error)
 }
 
 type staffServiceClient struct {
@@ -77,6 +116,16 @@ func (c *staffServiceClient) UpdateStaffMember(ctx context.Context, in *UpdateSt
 	return out, nil
 }
 
+func (c *staffServiceClient) UpsertStaffMember(ctx context.Context, in *UpsertStaffMemberRequest, opts ...grpc.CallOption) (*UpsertStaffMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertStaffMemberResponse)
+	err := c.cc.Invoke(ctx, StaffService_UpsertStaffMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *staffServiceClient) DeleteStaffMember(ctx context.Context, in *DeleteStaffMemberRequest, opts ...grpc.CallOption) (*DeleteStaffMemberResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteStaffMemberResponse)
@@ -87,6 +136,147 @@ func (c *staffServiceClient) DeleteStaffMember(ctx context.Context, in *DeleteSt
 	return out, nil
 }
 
+func (c *staffServiceClient) ListStaffMembers(ctx context.Context, in *ListStaffMembersRequest, opts ...grpc.CallOption) (*ListStaffMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaffMembersResponse)
+	err := c.cc.Invoke(ctx, StaffService_ListStaffMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) SearchStaffMembers(ctx context.Context, in *SearchStaffMembersRequest, opts ...grpc.CallOption) (*SearchStaffMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchStaffMembersResponse)
+	err := c.cc.Invoke(ctx, StaffService_SearchStaffMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) RestoreStaffMember(ctx context.Context, in *RestoreStaffMemberRequest, opts ...grpc.CallOption) (*RestoreStaffMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreStaffMemberResponse)
+	err := c.cc.Invoke(ctx, StaffService_RestoreStaffMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) PurgeStaffMember(ctx context.Context, in *PurgeStaffMemberRequest, opts ...grpc.CallOption) (*PurgeStaffMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeStaffMemberResponse)
+	err := c.cc.Invoke(ctx, StaffService_PurgeStaffMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ListStaffAuditEvents(ctx context.Context, in *ListStaffAuditEventsRequest, opts ...grpc.CallOption) (*ListStaffAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaffAuditEventsResponse)
+	err := c.cc.Invoke(ctx, StaffService_ListStaffAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) WatchStaffMembers(ctx context.Context, in *WatchStaffMembersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchStaffMembersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StaffService_ServiceDesc.Streams[0], StaffService_WatchStaffMembers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchStaffMembersRequest, WatchStaffMembersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StaffService_WatchStaffMembersClient = grpc.ServerStreamingClient[WatchStaffMembersResponse]
+
+func (c *staffServiceClient) BatchGetStaffMembers(ctx context.Context, in *BatchGetStaffMembersRequest, opts ...grpc.CallOption) (*BatchGetStaffMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetStaffMembersResponse)
+	err := c.cc.Invoke(ctx, StaffService_BatchGetStaffMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) BatchCreateStaffMembers(ctx context.Context, in *BatchCreateStaffMembersRequest, opts ...grpc.CallOption) (*BatchCreateStaffMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateStaffMembersResponse)
+	err := c.cc.Invoke(ctx, StaffService_BatchCreateStaffMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) BatchUpdateStaffMembers(ctx context.Context, in *BatchUpdateStaffMembersRequest, opts ...grpc.CallOption) (*BatchUpdateStaffMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateStaffMembersResponse)
+	err := c.cc.Invoke(ctx, StaffService_BatchUpdateStaffMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) BatchDeleteStaffMembers(ctx context.Context, in *BatchDeleteStaffMembersRequest, opts ...grpc.CallOption) (*BatchDeleteStaffMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteStaffMembersResponse)
+	err := c.cc.Invoke(ctx, StaffService_BatchDeleteStaffMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ImportStaffMembers(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportStaffMembersRequest, ImportStaffMembersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StaffService_ServiceDesc.Streams[1], StaffService_ImportStaffMembers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportStaffMembersRequest, ImportStaffMembersResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StaffService_ImportStaffMembersClient = grpc.ClientStreamingClient[ImportStaffMembersRequest, ImportStaffMembersResponse]
+
+func (c *staffServiceClient) ExportStaffMembers(ctx context.Context, in *ExportStaffMembersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportStaffMembersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StaffService_ServiceDesc.Streams[2], StaffService_ExportStaffMembers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportStaffMembersRequest, ExportStaffMembersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StaffService_ExportStaffMembersClient = grpc.ServerStreamingClient[ExportStaffMembersResponse]
+
 // StaffServiceServer is the server API for StaffService service.
 // All implementations must embed UnimplementedStaffServiceServer
 // for forward compatibility.
@@ -97,8 +287,34 @@ type StaffServiceServer interface {
 	CreateStaffMember(context.Context, *CreateStaffMemberRequest) (*CreateStaffMemberResponse, error)
 	// Update a staff member
 	UpdateStaffMember(context.Context, *UpdateStaffMemberRequest) (*UpdateStaffMemberResponse, error)
+	// Create a staff member, or update the staff member with the same staffID or email
+	UpsertStaffMember(context.Context, *UpsertStaffMemberRequest) (*UpsertStaffMemberResponse, error)
 	// Delete a staff member
 	DeleteStaffMember(context.Context, *DeleteStaffMemberRequest) (*DeleteStaffMemberResponse, error)
+	// List staff members page by page
+	ListStaffMembers(context.Context, *ListStaffMembersRequest) (*ListStaffMembersResponse, error)
+	// Search staff members by name, email or office
+	SearchStaffMembers(context.Context, *SearchStaffMembersRequest) (*SearchStaffMembersResponse, error)
+	// Restore a deleted staff member
+	RestoreStaffMember(context.Context, *RestoreStaffMemberRequest) (*RestoreStaffMemberResponse, error)
+	// Permanently remove a deleted staff member
+	PurgeStaffMember(context.Context, *PurgeStaffMemberRequest) (*PurgeStaffMemberResponse, error)
+	// List the audit log of changes to staff members, newest first
+	ListStaffAuditEvents(context.Context, *ListStaffAuditEventsRequest) (*ListStaffAuditEventsResponse, error)
+	// Stream the changes to staff members as they are committed
+	WatchStaffMembers(*WatchStaffMembersRequest, grpc.ServerStreamingServer[WatchStaffMembersResponse]) error
+	// Get several staff members by ID
+	BatchGetStaffMembers(context.Context, *BatchGetStaffMembersRequest) (*BatchGetStaffMembersResponse, error)
+	// Create several staff members in a single transaction
+	BatchCreateStaffMembers(context.Context, *BatchCreateStaffMembersRequest) (*BatchCreateStaffMembersResponse, error)
+	// Update several staff members in a single transaction
+	BatchUpdateStaffMembers(context.Context, *BatchUpdateStaffMembersRequest) (*BatchUpdateStaffMembersResponse, error)
+	// Delete several staff members in a single transaction
+	BatchDeleteStaffMembers(context.Context, *BatchDeleteStaffMembersRequest) (*BatchDeleteStaffMembersResponse, error)
+	// Import a roster of staff members from a CSV or XLSX file sent in chunks
+	ImportStaffMembers(grpc.ClientStreamingServer[ImportStaffMembersRequest, ImportStaffMembersResponse]) error
+	// Export the staff directory as a CSV, NDJSON or vCard file streamed in chunks
+	ExportStaffMembers(*ExportStaffMembersRequest, grpc.ServerStreamingServer[ExportStaffMembersResponse]) error
 	mustEmbedUnimplementedStaffServiceServer()
 }
 
@@ -118,9 +334,48 @@ func (UnimplementedStaffServiceServer) CreateStaffMember(context.Context, *Creat
 func (UnimplementedStaffServiceServer) UpdateStaffMember(context.Context, *UpdateStaffMemberRequest) (*UpdateStaffMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStaffMember not implemented")
 }
+func (UnimplementedStaffServiceServer) UpsertStaffMember(context.Context, *UpsertStaffMemberRequest) (*UpsertStaffMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertStaffMember not implemented")
+}
 func (UnimplementedStaffServiceServer) DeleteStaffMember(context.Context, *DeleteStaffMemberRequest) (*DeleteStaffMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStaffMember not implemented")
 }
+func (UnimplementedStaffServiceServer) ListStaffMembers(context.Context, *ListStaffMembersRequest) (*ListStaffMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaffMembers not implemented")
+}
+func (UnimplementedStaffServiceServer) SearchStaffMembers(context.Context, *SearchStaffMembersRequest) (*SearchStaffMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStaffMembers not implemented")
+}
+func (UnimplementedStaffServiceServer) RestoreStaffMember(context.Context, *RestoreStaffMemberRequest) (*RestoreStaffMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreStaffMember not implemented")
+}
+func (UnimplementedStaffServiceServer) PurgeStaffMember(context.Context, *PurgeStaffMemberRequest) (*PurgeStaffMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeStaffMember not implemented")
+}
+func (UnimplementedStaffServiceServer) ListStaffAuditEvents(context.Context, *ListStaffAuditEventsRequest) (*ListStaffAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaffAuditEvents not implemented")
+}
+func (UnimplementedStaffServiceServer) WatchStaffMembers(*WatchStaffMembersRequest, grpc.ServerStreamingServer[WatchStaffMembersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStaffMembers not implemented")
+}
+func (UnimplementedStaffServiceServer) BatchGetStaffMembers(context.Context, *BatchGetStaffMembersRequest) (*BatchGetStaffMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetStaffMembers not implemented")
+}
+func (UnimplementedStaffServiceServer) BatchCreateStaffMembers(context.Context, *BatchCreateStaffMembersRequest) (*BatchCreateStaffMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateStaffMembers not implemented")
+}
+func (UnimplementedStaffServiceServer) BatchUpdateStaffMembers(context.Context, *BatchUpdateStaffMembersRequest) (*BatchUpdateStaffMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateStaffMembers not implemented")
+}
+func (UnimplementedStaffServiceServer) BatchDeleteStaffMembers(context.Context, *BatchDeleteStaffMembersRequest) (*BatchDeleteStaffMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteStaffMembers not implemented")
+}
+func (UnimplementedStaffServiceServer) ImportStaffMembers(grpc.ClientStreamingServer[ImportStaffMembersRequest, ImportStaffMembersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportStaffMembers not implemented")
+}
+func (UnimplementedStaffServiceServer) ExportStaffMembers(*ExportStaffMembersRequest, grpc.ServerStreamingServer[ExportStaffMembersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStaffMembers not implemented")
+}
 func (UnimplementedStaffServiceServer) mustEmbedUnimplementedStaffServiceServer() {}
 func (UnimplementedStaffServiceServer) testEmbeddedByValue()                      {}
 
@@ -196,6 +451,24 @@ func _StaffService_UpdateStaffMember_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StaffService_UpsertStaffMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertStaffMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).UpsertStaffMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_UpsertStaffMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).UpsertStaffMember(ctx, req.(*UpsertStaffMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StaffService_DeleteStaffMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteStaffMemberRequest)
 	if err := dec(in); err != nil {
@@ -214,6 +487,197 @@ func _StaffService_DeleteStaffMember_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StaffService_ListStaffMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaffMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ListStaffMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ListStaffMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ListStaffMembers(ctx, req.(*ListStaffMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_SearchStaffMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStaffMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).SearchStaffMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_SearchStaffMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).SearchStaffMembers(ctx, req.(*SearchStaffMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_RestoreStaffMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreStaffMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).RestoreStaffMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_RestoreStaffMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).RestoreStaffMember(ctx, req.(*RestoreStaffMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_PurgeStaffMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeStaffMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).PurgeStaffMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_PurgeStaffMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).PurgeStaffMember(ctx, req.(*PurgeStaffMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ListStaffAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaffAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ListStaffAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ListStaffAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ListStaffAuditEvents(ctx, req.(*ListStaffAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_WatchStaffMembers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStaffMembersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StaffServiceServer).WatchStaffMembers(m, &grpc.GenericServerStream[WatchStaffMembersRequest, WatchStaffMembersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StaffService_WatchStaffMembersServer = grpc.ServerStreamingServer[WatchStaffMembersResponse]
+
+func _StaffService_BatchGetStaffMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetStaffMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).BatchGetStaffMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_BatchGetStaffMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).BatchGetStaffMembers(ctx, req.(*BatchGetStaffMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_BatchCreateStaffMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateStaffMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).BatchCreateStaffMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_BatchCreateStaffMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).BatchCreateStaffMembers(ctx, req.(*BatchCreateStaffMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_BatchUpdateStaffMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateStaffMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).BatchUpdateStaffMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_BatchUpdateStaffMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).BatchUpdateStaffMembers(ctx, req.(*BatchUpdateStaffMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_BatchDeleteStaffMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteStaffMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).BatchDeleteStaffMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_BatchDeleteStaffMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).BatchDeleteStaffMembers(ctx, req.(*BatchDeleteStaffMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ImportStaffMembers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StaffServiceServer).ImportStaffMembers(&grpc.GenericServerStream[ImportStaffMembersRequest, ImportStaffMembersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StaffService_ImportStaffMembersServer = grpc.ClientStreamingServer[ImportStaffMembersRequest, ImportStaffMembersResponse]
+
+func _StaffService_ExportStaffMembers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStaffMembersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StaffServiceServer).ExportStaffMembers(m, &grpc.GenericServerStream[ExportStaffMembersRequest, ExportStaffMembersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StaffService_ExportStaffMembersServer = grpc.ServerStreamingServer[ExportStaffMembersResponse]
+
 // StaffService_ServiceDesc is the grpc.ServiceDesc for StaffService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,11 +697,67 @@ var StaffService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStaffMember",
 			Handler:    _StaffService_UpdateStaffMember_Handler,
 		},
+		{
+			MethodName: "UpsertStaffMember",
+			Handler:    _StaffService_UpsertStaffMember_Handler,
+		},
 		{
 			MethodName: "DeleteStaffMember",
 			Handler:    _StaffService_DeleteStaffMember_Handler,
 		},
+		{
+			MethodName: "ListStaffMembers",
+			Handler:    _StaffService_ListStaffMembers_Handler,
+		},
+		{
+			MethodName: "SearchStaffMembers",
+			Handler:    _StaffService_SearchStaffMembers_Handler,
+		},
+		{
+			MethodName: "RestoreStaffMember",
+			Handler:    _StaffService_RestoreStaffMember_Handler,
+		},
+		{
+			MethodName: "PurgeStaffMember",
+			Handler:    _StaffService_PurgeStaffMember_Handler,
+		},
+		{
+			MethodName: "ListStaffAuditEvents",
+			Handler:    _StaffService_ListStaffAuditEvents_Handler,
+		},
+		{
+			MethodName: "BatchGetStaffMembers",
+			Handler:    _StaffService_BatchGetStaffMembers_Handler,
+		},
+		{
+			MethodName: "BatchCreateStaffMembers",
+			Handler:    _StaffService_BatchCreateStaffMembers_Handler,
+		},
+		{
+			MethodName: "BatchUpdateStaffMembers",
+			Handler:    _StaffService_BatchUpdateStaffMembers_Handler,
+		},
+		{
+			MethodName: "BatchDeleteStaffMembers",
+			Handler:    _StaffService_BatchDeleteStaffMembers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStaffMembers",
+			Handler:       _StaffService_WatchStaffMembers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportStaffMembers",
+			Handler:       _StaffService_ImportStaffMembers_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportStaffMembers",
+			Handler:       _StaffService_ExportStaffMembers_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "staff-microservice.proto",
 }
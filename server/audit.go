@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc"
+)
+
+var ErrInvalidTimeRange = errors.New("invalid time range")
+
+// auditAction is the kind of change recorded by an audit event.
+// Its values are the StaffAuditAction names without the AUDIT_ACTION_ prefix.
+type auditAction string
+
+const (
+	auditActionCreate  auditAction = "CREATE"
+	auditActionUpdate  auditAction = "UPDATE"
+	auditActionDelete  auditAction = "DELETE"
+	auditActionRestore auditAction = "RESTORE"
+	auditActionPurge   auditAction = "PURGE"
+)
+
+// StaffAuditEvent represents the staff_audit_log table.
+// Events are inserted in the transaction of the change they record and are never modified.
+// Before and After hold the values of the fields the change modified, keyed by their StaffMember field names.
+type StaffAuditEvent struct {
+	bun.BaseModel `bun:"table:staff_audit_log"`
+
+	EventID   int64             `bun:"event_id,pk,autoincrement"`
+	StaffID   string            `bun:"staff_id,notnull"`
+	Action    auditAction       `bun:"action,notnull"`
+	RPC       string            `bun:"rpc,notnull"`
+	Actor     string            `bun:"actor,notnull"`
+	RequestID string            `bun:"request_id,notnull"`
+	Before    map[string]string `bun:"before,type:jsonb"`
+	After     map[string]string `bun:"after,type:jsonb"`
+	CreatedAt time.Time         `bun:"created_at,notnull"`
+}
+
+// auditSnapshot returns the audited fields of a staff member, keyed by their StaffMember field names.
+// deletedAt is only present for deleted staff members.
+func auditSnapshot(staff *StaffMember) map[string]string {
+	if staff == nil {
+		return nil
+	}
+
+	snapshot := map[string]string{
+		"staffID":     staff.StaffID,
+		"firstName":   staff.FirstName,
+		"lastName":    staff.LastName,
+		"email":       staff.Email,
+		"phoneNumber": staff.PhoneNumber,
+		"title":       staff.Title,
+		"office":      staff.Office,
+		"subject":     staff.Subject,
+	}
+
+	if !staff.DeletedAt.IsZero() {
+		snapshot["deletedAt"] = staff.DeletedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	return snapshot
+}
+
+// auditDiff returns the fields whose values differ between two snapshots,
+// with their values in each snapshot. Fields missing from a snapshot are left out of its result.
+func auditDiff(before, after map[string]string) (map[string]string, map[string]string) {
+	changedBefore, changedAfter := make(map[string]string), make(map[string]string)
+
+	for field, value := range after {
+		if oldValue, ok := before[field]; !ok || oldValue != value {
+			changedAfter[field] = value
+		}
+	}
+
+	for field, value := range before {
+		if newValue, ok := after[field]; !ok || newValue != value {
+			changedBefore[field] = value
+		}
+	}
+
+	return changedBefore, changedAfter
+}
+
+// recordAuditEvent inserts the audit event of a change within the transaction making the change.
+func recordAuditEvent(ctx context.Context, tx bun.IDB, action auditAction, before, after *StaffMember) error {
+	event := newStaffAuditEvent(ctx, action, before, after)
+
+	if _, err := tx.NewInsert().Model(event).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record audit event: %w", translateDBError(err))
+	}
+
+	return nil
+}
+
+// newStaffAuditEvent returns the audit event of a change, without its EventID.
+// before is nil for created staff members and after is nil for purged ones.
+// The actor and request ID are read from the context, as set by withActor.
+func newStaffAuditEvent(ctx context.Context, action auditAction, before, after *StaffMember) *StaffAuditEvent {
+	event := &StaffAuditEvent{
+		Action:    action,
+		Actor:     actorFromContext(ctx),
+		RequestID: requestIDFromContext(ctx),
+		CreatedAt: time.Now(),
+	}
+
+	if after != nil {
+		event.StaffID = after.StaffID
+	} else {
+		event.StaffID = before.StaffID
+	}
+
+	event.RPC, _ = grpc.Method(ctx)
+	event.Before, event.After = auditDiff(auditSnapshot(before), auditSnapshot(after))
+
+	return event
+}
+
+// StaffAuditFilter narrows down the events returned by ListStaffAuditEvents.
+// Empty fields are not filtered on. From is inclusive and To is exclusive.
+type StaffAuditFilter struct {
+	StaffID string
+	Actor   string
+	From    time.Time
+	To      time.Time
+}
+
+// StaffAuditPage is a single page of audit events.
+type StaffAuditPage struct {
+	Events        []*StaffAuditEvent
+	NextPageToken string
+}
+
+// ListStaffAuditEvents returns a page of audit events, newest first.
+// Page tokens hold the ID of the last event of the previous page.
+func (d *Database) ListStaffAuditEvents(ctx context.Context, filter StaffAuditFilter,
+	pageSize int, pageToken string,
+) (*StaffAuditPage, error) {
+	pageSize, err := normalizeAuditListParams(filter, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*StaffAuditEvent
+
+	query := d.db.NewSelect().Model(&events)
+
+	if filter.StaffID != "" {
+		query = query.Where("staff_id = ?", filter.StaffID)
+	}
+
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+
+	if !filter.From.IsZero() {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+
+	if !filter.To.IsZero() {
+		query = query.Where("created_at < ?", filter.To)
+	}
+
+	if pageToken != "" {
+		lastEventID, err := decodeAuditPageToken(pageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		query = query.Where("event_id < ?", lastEventID)
+	}
+
+	query = query.OrderExpr("event_id DESC").Limit(pageSize + 1)
+
+	err = d.read(ctx, func(ctx context.Context) error {
+		if err := query.Scan(ctx); err != nil {
+			return fmt.Errorf("failed to list audit events: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newStaffAuditPage(events, pageSize), nil
+}
+
+// normalizeAuditListParams validates the listing parameters of ListStaffAuditEvents and returns the page size.
+func normalizeAuditListParams(filter StaffAuditFilter, pageSize int) (int, error) {
+	if pageSize < 0 {
+		return 0, fmt.Errorf("%w: %d", ErrInvalidPageSize, pageSize)
+	}
+
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return 0, fmt.Errorf("%w: from must be before to", ErrInvalidTimeRange)
+	}
+
+	return min(pageSize, maxPageSize), nil
+}
+
+// newStaffAuditPage returns the page of the events read for it, which are one more than
+// the page size if there is a next page.
+func newStaffAuditPage(events []*StaffAuditEvent, pageSize int) *StaffAuditPage {
+	page := &StaffAuditPage{Events: events}
+
+	if len(events) > pageSize {
+		page.Events = events[:pageSize]
+		page.NextPageToken = base64.RawURLEncoding.EncodeToString(
+			strconv.AppendInt(nil, page.Events[pageSize-1].EventID, 10))
+	}
+
+	return page
+}
+
+// decodeAuditPageToken returns the ID of the last event of the page before the token.
+func decodeAuditPageToken(pageToken string) (int64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, fmt.Errorf("%w", ErrInvalidPageToken)
+	}
+
+	eventID, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w", ErrInvalidPageToken)
+	}
+
+	return eventID, nil
+}
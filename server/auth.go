@@ -0,0 +1,364 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	ms "github.com/TekClinic/MicroService-Lib"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Roles recognized by the StaffService authorization policy.
+const (
+	roleAdmin   = "admin"
+	roleStaff   = "staff"
+	roleStudent = "student"
+	// roleImporter may choose the staffID of the staff members it creates.
+	roleImporter = "importer"
+)
+
+// accessLevel is the access a role is granted to an RPC.
+type accessLevel int
+
+const (
+	// accessNone denies the RPC.
+	accessNone accessLevel = iota
+	// accessPublic allows reading the public subset of the staff member fields.
+	accessPublic
+	// accessOwn allows the RPC on the caller's own staff record only.
+	accessOwn
+	// accessFull allows the RPC on any staff record.
+	accessFull
+)
+
+// rpcPolicies maps every StaffService RPC to the access granted to each role.
+// A caller with several roles gets the highest access of them.
+// RPCs missing from the table are denied to everyone.
+var rpcPolicies = map[string]map[string]accessLevel{
+	spb.StaffService_GetStaffMember_FullMethodName: {
+		roleAdmin: accessFull, roleStaff: accessFull, roleStudent: accessPublic,
+	},
+	spb.StaffService_CreateStaffMember_FullMethodName: {
+		roleAdmin: accessFull, roleImporter: accessFull,
+	},
+	spb.StaffService_UpdateStaffMember_FullMethodName: {
+		roleAdmin: accessFull, roleStaff: accessOwn,
+	},
+	spb.StaffService_UpsertStaffMember_FullMethodName: {
+		roleAdmin: accessFull, roleImporter: accessFull,
+	},
+	spb.StaffService_DeleteStaffMember_FullMethodName: {
+		roleAdmin: accessFull,
+	},
+	spb.StaffService_ListStaffMembers_FullMethodName: {
+		roleAdmin: accessFull, roleStaff: accessFull, roleStudent: accessPublic,
+	},
+	spb.StaffService_SearchStaffMembers_FullMethodName: {
+		roleAdmin: accessFull, roleStaff: accessFull, roleStudent: accessPublic,
+	},
+	spb.StaffService_RestoreStaffMember_FullMethodName: {
+		roleAdmin: accessFull,
+	},
+	spb.StaffService_PurgeStaffMember_FullMethodName: {
+		roleAdmin: accessFull,
+	},
+	spb.StaffService_ListStaffAuditEvents_FullMethodName: {
+		roleAdmin: accessFull,
+	},
+	spb.StaffService_WatchStaffMembers_FullMethodName: {
+		roleAdmin: accessFull, roleStaff: accessFull, roleStudent: accessPublic,
+	},
+	spb.StaffService_BatchGetStaffMembers_FullMethodName: {
+		roleAdmin: accessFull, roleStaff: accessFull, roleStudent: accessPublic,
+	},
+	spb.StaffService_BatchCreateStaffMembers_FullMethodName: {
+		roleAdmin: accessFull, roleImporter: accessFull,
+	},
+	spb.StaffService_BatchUpdateStaffMembers_FullMethodName: {
+		roleAdmin: accessFull, roleStaff: accessOwn,
+	},
+	spb.StaffService_BatchDeleteStaffMembers_FullMethodName: {
+		roleAdmin: accessFull,
+	},
+	spb.StaffService_ImportStaffMembers_FullMethodName: {
+		roleAdmin: accessFull, roleImporter: accessFull,
+	},
+	spb.StaffService_ExportStaffMembers_FullMethodName: {
+		roleAdmin: accessFull, roleStaff: accessFull, roleStudent: accessPublic,
+	},
+}
+
+// subjectClaims is implemented by Claims that know the subject they were issued to.
+type subjectClaims interface {
+	GetSubject() string
+}
+
+const (
+	// tokenAudience is the audience the tokens must be issued for, as ms.BaseServiceServer expects.
+	tokenAudience = "account"
+	// rolesClaim is the key of the roles in the claims of a token.
+	rolesClaim = "roles"
+)
+
+// TokenVerifier verifies tokens with the OpenID provider of an issuer, as ms.BaseServiceServer does,
+// but returns Claims that also know the subject of the token.
+type TokenVerifier struct {
+	issuer string
+
+	mu       sync.Mutex
+	verifier *oidc.IDTokenVerifier
+}
+
+// NewTokenVerifier returns a TokenVerifier of the tokens of the issuer, such as AUTH_ISSUER.
+// The provider is discovered on the first verification.
+func NewTokenVerifier(issuer string) *TokenVerifier {
+	return &TokenVerifier{issuer: issuer}
+}
+
+// Verify checks the signature, issuer, audience and expiry of the token and returns its claims.
+// Roles are read as ms.BaseServiceServer reads them: the roles claim, the Keycloak realm roles,
+// and the Keycloak client roles as <client>.<role>.
+func (v *TokenVerifier) Verify(ctx context.Context, rawToken string) (ms.Claims, error) {
+	verifier, err := v.idTokenVerifier(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	idToken, err := verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify token: %w", err)
+	}
+
+	var payload struct {
+		ResourceAccess map[string]map[string][]string `json:"resource_access"`
+		RealmAccess    map[string][]string            `json:"realm_access"`
+		Roles          []string                       `json:"roles"`
+	}
+
+	if err := idToken.Claims(&payload); err != nil {
+		return nil, fmt.Errorf("failed to read token claims: %w", err)
+	}
+
+	roles := sets.New(payload.Roles...)
+	roles.Insert(payload.RealmAccess[rolesClaim]...)
+
+	for client, access := range payload.ResourceAccess {
+		for _, role := range access[rolesClaim] {
+			roles.Insert(client + "." + role)
+		}
+	}
+
+	return &tokenClaims{roles: roles, subject: idToken.Subject}, nil
+}
+
+// idTokenVerifier returns the verifier of the provider, discovering the provider unless it already was.
+func (v *TokenVerifier) idTokenVerifier(ctx context.Context) (*oidc.IDTokenVerifier, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.verifier == nil {
+		// The provider fetches its signing keys with the context it was discovered with.
+		provider, err := oidc.NewProvider(context.WithoutCancel(ctx), v.issuer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover the OpenID provider: %w", err)
+		}
+
+		v.verifier = provider.Verifier(&oidc.Config{ClientID: tokenAudience})
+	}
+
+	return v.verifier, nil
+}
+
+// tokenClaims are the Claims of a token verified by a TokenVerifier.
+type tokenClaims struct {
+	roles   sets.Set[string]
+	subject string
+}
+
+var _ subjectClaims = (*tokenClaims)(nil)
+
+// HasRole implements ms.Claims.
+func (c *tokenClaims) HasRole(role string) bool {
+	return c.roles.Has(role)
+}
+
+// GetRoles implements ms.Claims.
+func (c *tokenClaims) GetRoles() sets.Set[string] {
+	return c.roles.Clone()
+}
+
+// GetSubject returns the "sub" claim of the token.
+func (c *tokenClaims) GetSubject() string {
+	return c.subject
+}
+
+// requestIDMetadataKey is the gRPC metadata key clients may use to identify their requests in the audit log.
+const requestIDMetadataKey = "x-request-id"
+
+// requestInfo describes the request whose changes are being made.
+type requestInfo struct {
+	// actor is the subject making the changes.
+	actor string
+	// requestID identifies the request in the audit log.
+	requestID string
+}
+
+// requestInfoKey is the context key of the requestInfo.
+type requestInfoKey struct{}
+
+// withActor returns a context recording the subject making the changes of a request.
+// The request is identified by the x-request-id metadata sent by the client, or by a new ID if it sent none.
+func withActor(ctx context.Context, actor string) context.Context {
+	requestID := ""
+	if values := metadata.ValueFromIncomingContext(ctx, requestIDMetadataKey); len(values) > 0 {
+		requestID = values[0]
+	}
+
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+
+	return context.WithValue(ctx, requestInfoKey{}, requestInfo{actor: actor, requestID: requestID})
+}
+
+// actorFromContext returns the subject recorded by withActor, or "" if there is none.
+func actorFromContext(ctx context.Context) string {
+	info, _ := ctx.Value(requestInfoKey{}).(requestInfo)
+
+	return info.actor
+}
+
+// requestIDFromContext returns the request ID recorded by withActor, or "" if there is none.
+func requestIDFromContext(ctx context.Context) string {
+	info, _ := ctx.Value(requestInfoKey{}).(requestInfo)
+
+	return info.requestID
+}
+
+// caller is the authenticated identity behind a request and the access it was granted.
+type caller struct {
+	// subject is the "sub" claim of the token.
+	subject string
+	// ownStaffID is the staffID of the staff member whose subject is the caller's, for callers with accessOwn.
+	ownStaffID string
+	access     accessLevel
+	claims     ms.Claims
+}
+
+// authorize verifies the token and checks that rpcPolicies grants the caller access to the RPC.
+func (s *StaffServer) authorize(ctx context.Context, token, method string) (*caller, error) {
+	claims, err := s.VerifyToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	access := accessNone
+
+	for role, level := range rpcPolicies[method] {
+		if claims.HasRole(role) {
+			access = max(access, level)
+		}
+	}
+
+	if access == accessNone {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Errorf(codes.PermissionDenied, "caller is not allowed to call %s", method))
+	}
+
+	caller := &caller{subject: claimsSubject(claims), access: access, claims: claims}
+
+	// Callers who may only act on their own staff record find it by their subject.
+	if access == accessOwn {
+		own, err := s.store.GetStaffMemberBySubject(ctx, caller.subject)
+
+		switch {
+		case err == nil:
+			caller.ownStaffID = own.StaffID
+		case !errors.Is(err, ErrStaffMemberNotFound):
+			return nil, statusError(ctx, "authorization failed", err)
+		}
+	}
+
+	return caller, nil
+}
+
+// authorizeStaffMember checks that the caller may act on the given staff record.
+func (c *caller) authorizeStaffMember(staffID string) error {
+	if c.access == accessOwn && (c.ownStaffID == "" || c.ownStaffID != staffID) {
+		return fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "caller may only act on their own staff record"))
+	}
+
+	return nil
+}
+
+// authorizeUpdate checks that the caller may update the given staff record with the update mask.
+// Callers who may only act on their own staff record may not change its subject.
+func (c *caller) authorizeUpdate(staff *spb.StaffMember, updateMask []string) error {
+	if err := c.authorizeStaffMember(staff.GetStaffID()); err != nil {
+		return err
+	}
+
+	setsSubject := slices.Contains(updateMask, "subject") || (len(updateMask) == 0 && staff.GetSubject() != "")
+	if c.access == accessOwn && setsSubject && staff.GetSubject() != c.subject {
+		return fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "only admins may change the subject of a staff member"))
+	}
+
+	return nil
+}
+
+// authorizeIncludeDeleted checks that the caller may read deleted staff members if asked to.
+func (c *caller) authorizeIncludeDeleted(includeDeleted bool) error {
+	if includeDeleted && !c.claims.HasRole(roleAdmin) {
+		return fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "only admins may read deleted staff members"))
+	}
+
+	return nil
+}
+
+// authorizeStaffID checks that the caller may choose the staffID of a new staff member.
+func (c *caller) authorizeStaffID(staffID string) error {
+	if staffID != "" && !c.claims.HasRole(roleImporter) {
+		return fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "only importers may choose the staffID"))
+	}
+
+	return nil
+}
+
+// view returns the part of the staff member the caller may read.
+func (c *caller) view(staff *spb.StaffMember) *spb.StaffMember {
+	if c.access != accessPublic {
+		return staff
+	}
+
+	return &spb.StaffMember{
+		StaffID:   staff.GetStaffID(),
+		FirstName: staff.GetFirstName(),
+		LastName:  staff.GetLastName(),
+		Email:     staff.GetEmail(),
+		Title:     staff.GetTitle(),
+		Office:    staff.GetOffice(),
+	}
+}
+
+// claimsSubject returns the subject of the verified claims, or "" if they do not know it.
+func claimsSubject(claims ms.Claims) string {
+	if withSubject, ok := claims.(subjectClaims); ok {
+		return withSubject.GetSubject()
+	}
+
+	return ""
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/status"
+)
+
+var ErrBatchTooLarge = errors.New("batch is too large")
+
+// maxBatchSize caps the number of items of a batch.
+const maxBatchSize = 500
+
+// BatchMode selects how a batch handles failing items.
+type BatchMode int
+
+const (
+	// BatchAllOrNothing applies either every item of the batch or none of them.
+	BatchAllOrNothing BatchMode = iota
+	// BatchBestEffort applies the items that succeed and reports the errors of the others.
+	BatchBestEffort
+)
+
+// BatchItemResult is the outcome of a single item of a batch.
+type BatchItemResult struct {
+	// StaffMember is the staff member written by the item, if it succeeded and wrote one.
+	StaffMember *StaffMember
+	Err         error
+}
+
+// BatchItemError reports the item that failed an all-or-nothing batch.
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+// Error implements error.
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error of the item.
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+// StaffMemberUpdate is a single item of BatchUpdateStaffMembers.
+type StaffMemberUpdate struct {
+	StaffMember *spb.StaffMember
+	UpdateMask  []string
+	Etag        string
+}
+
+// StaffMemberDeletion is a single item of BatchDeleteStaffMembers.
+type StaffMemberDeletion struct {
+	StaffID string
+	Etag    string
+}
+
+// checkBatchSize checks that a batch is not larger than maxBatchSize.
+func checkBatchSize(size int) error {
+	if size > maxBatchSize {
+		return fmt.Errorf("%w: %d items, at most %d are allowed", ErrBatchTooLarge, size, maxBatchSize)
+	}
+
+	return nil
+}
+
+// runBatch applies every item of a batch within a single transaction.
+// In BatchAllOrNothing mode, the first failing item rolls back the whole batch and its error is
+// returned as a BatchItemError. In BatchBestEffort mode, every item runs in its own savepoint,
+// so that a failing item only rolls back its own changes, and its error is reported in its result.
+func (d *Database) runBatch(ctx context.Context, mode BatchMode, size int,
+	apply func(ctx context.Context, tx bun.Tx, index int) (*StaffMember, error),
+) ([]BatchItemResult, error) {
+	if err := checkBatchSize(size); err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchItemResult, size)
+
+	err := d.runInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		for index := range size {
+			if mode == BatchAllOrNothing {
+				staff, err := apply(ctx, tx, index)
+				if err != nil {
+					return &BatchItemError{Index: index, Err: err}
+				}
+
+				results[index].StaffMember = staff
+
+				continue
+			}
+
+			err := tx.RunInTx(ctx, nil, func(ctx context.Context, savepoint bun.Tx) error {
+				staff, err := apply(ctx, savepoint, index)
+				results[index] = BatchItemResult{StaffMember: staff, Err: err}
+
+				return err
+			})
+			if err != nil && results[index].Err == nil {
+				// The savepoint itself failed, which aborts the transaction.
+				return fmt.Errorf("failed to apply batch item %d: %w", index, translateDBError(err))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetStaffMembers retrieves the staff members with the given IDs.
+// Staff members that do not exist are left out, and deleted ones are only returned if includeDeleted is set.
+func (d *Database) GetStaffMembers(ctx context.Context, staffIDs []string, includeDeleted bool,
+) ([]*StaffMember, error) {
+	if err := checkBatchSize(len(staffIDs)); err != nil {
+		return nil, err
+	}
+
+	if len(staffIDs) == 0 {
+		return nil, nil
+	}
+
+	var staffMembers []*StaffMember
+
+	query := d.db.NewSelect().Model(&staffMembers).Where("staff_id IN (?)", bun.In(staffIDs))
+	if includeDeleted {
+		query = query.WhereAllWithDeleted()
+	}
+
+	err := d.read(ctx, func(ctx context.Context) error {
+		if err := query.Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get staff members: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return staffMembers, nil
+}
+
+// BatchAddStaffMembers adds new staff members, as AddStaffMember does, in a single transaction.
+func (d *Database) BatchAddStaffMembers(ctx context.Context, staffMembers []*spb.StaffMember,
+	mode BatchMode,
+) ([]BatchItemResult, error) {
+	return d.runBatch(ctx, mode, len(staffMembers),
+		func(ctx context.Context, tx bun.Tx, index int) (*StaffMember, error) {
+			return addStaffMember(ctx, tx, staffMembers[index])
+		})
+}
+
+// BatchUpdateStaffMembers updates existing staff members, as UpdateStaffMember does, in a single transaction.
+func (d *Database) BatchUpdateStaffMembers(ctx context.Context, updates []StaffMemberUpdate,
+	mode BatchMode,
+) ([]BatchItemResult, error) {
+	return d.runBatch(ctx, mode, len(updates),
+		func(ctx context.Context, tx bun.Tx, index int) (*StaffMember, error) {
+			update := updates[index]
+
+			return updateStaffMember(ctx, tx, update.StaffMember, update.UpdateMask, update.Etag)
+		})
+}
+
+// BatchDeleteStaffMembers marks staff members as deleted, as DeleteStaffMember does, in a single transaction.
+func (d *Database) BatchDeleteStaffMembers(ctx context.Context, deletions []StaffMemberDeletion,
+	mode BatchMode,
+) ([]BatchItemResult, error) {
+	return d.runBatch(ctx, mode, len(deletions),
+		func(ctx context.Context, tx bun.Tx, index int) (*StaffMember, error) {
+			return nil, deleteStaffMember(ctx, tx, deletions[index].StaffID, deletions[index].Etag)
+		})
+}
+
+// runBatchItems runs a batch RPC: it checks every item, applies the items that pass the checks,
+// and returns the result of every item in the order of the request.
+// In all-or-nothing mode, an item failing the checks or being applied fails the whole batch,
+// with an error naming the item by its field, such as staffMembers[3].
+func runBatchItems[T any](ctx context.Context, msg, field string, mode spb.BatchMode, items []T,
+	check func(item T) error,
+	apply func(items []T, mode BatchMode) ([]BatchItemResult, error),
+) ([]*spb.BatchStaffMemberResult, error) {
+	if err := checkBatchSize(len(items)); err != nil {
+		return nil, statusError(ctx, msg, err)
+	}
+
+	bestEffort := mode == spb.BatchMode_BATCH_MODE_BEST_EFFORT
+	results := make([]*spb.BatchStaffMemberResult, len(items))
+	checked := make([]T, 0, len(items))
+	// indexes maps the checked items to their index in the request.
+	indexes := make([]int, 0, len(items))
+
+	for index, item := range items {
+		if err := check(item); err != nil {
+			if !bestEffort {
+				return nil, fmt.Errorf("%s[%d]: %w", field, index, err)
+			}
+
+			st := status.Convert(err)
+			results[index] = &spb.BatchStaffMemberResult{Code: int32(st.Code()), Message: st.Message()}
+
+			continue
+		}
+
+		checked = append(checked, item)
+		indexes = append(indexes, index)
+	}
+
+	batchMode := BatchAllOrNothing
+	if bestEffort {
+		batchMode = BatchBestEffort
+	}
+
+	applied, err := apply(checked, batchMode)
+	if err != nil {
+		var itemErr *BatchItemError
+		if errors.As(err, &itemErr) {
+			msg = fmt.Sprintf("%s: %s[%d]", msg, field, indexes[itemErr.Index])
+		}
+
+		return nil, statusError(ctx, msg, err)
+	}
+
+	for i, result := range applied {
+		itemResult := &spb.BatchStaffMemberResult{}
+
+		switch {
+		case result.Err != nil:
+			st := status.Convert(statusError(ctx, msg, result.Err))
+			itemResult.Code, itemResult.Message = int32(st.Code()), st.Message()
+		case result.StaffMember != nil:
+			itemResult.StaffMember = staffMemberToProto(result.StaffMember)
+		}
+
+		results[indexes[i]] = itemResult
+	}
+
+	return results, nil
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"k8s.io/klog/v2"
+)
+
+var (
+	ErrInvalidDSN        = errors.New("invalid DSN")
+	ErrDatabaseNameEmpty = errors.New("database name is empty")
+)
+
+const (
+	// maintenanceDatabase is the database connected to while creating the service database.
+	maintenanceDatabase = "postgres"
+	// bootstrapTimeout bounds the time spent waiting for PostgreSQL to accept connections on startup.
+	bootstrapTimeout = time.Minute
+)
+
+// bootstrapRetryPolicy retries connecting to PostgreSQL while it is starting, until bootstrapTimeout.
+var bootstrapRetryPolicy = retryPolicy{initialBackoff: 500 * time.Millisecond, maxBackoff: 8 * time.Second}
+
+// databaseName returns the name of the service database from DB_NAME, or from the deprecated DP_NAME.
+// It returns "" if neither is set, in which case the database named by the DSN is used.
+func databaseName() string {
+	if name := os.Getenv("DB_NAME"); name != "" {
+		return name
+	}
+
+	if name := os.Getenv("DP_NAME"); name != "" {
+		klog.Warning("DP_NAME is deprecated, set DB_NAME instead")
+
+		return name
+	}
+
+	return ""
+}
+
+// databaseDSN returns the DSN of the service database: the DSN environment variable,
+// with its database replaced by the one named by DB_NAME if that is set.
+func databaseDSN() (string, error) {
+	dsn := os.Getenv("DSN")
+
+	name := databaseName()
+	if name == "" {
+		return dsn, nil
+	}
+
+	return withDatabase(dsn, name)
+}
+
+// withDatabase returns the DSN connecting to the named database of the server the DSN connects to.
+func withDatabase(dsn, name string) (string, error) {
+	parsed, err := url.Parse(dsn)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDSN, err)
+	}
+
+	parsed.Path = "/" + name
+	parsed.RawPath = ""
+
+	return parsed.String(), nil
+}
+
+// dsnDatabase returns the name of the database the DSN connects to.
+func dsnDatabase(dsn string) (string, error) {
+	parsed, err := url.Parse(dsn)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDSN, err)
+	}
+
+	name := strings.TrimPrefix(parsed.Path, "/")
+	if name == "" {
+		return "", fmt.Errorf("%w: the DSN names no database", ErrDatabaseNameEmpty)
+	}
+
+	return name, nil
+}
+
+// openPostgres opens a connection pool to the database the DSN connects to.
+func openPostgres(dsn string) *bun.DB {
+	return bun.NewDB(sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn))), pgdialect.New())
+}
+
+// ensureDatabase creates the database the DSN connects to if it does not exist yet.
+// The database is created from the maintenance database of the same server, so only
+// the first start of the service needs permission to connect to it.
+// Connections failing while PostgreSQL is starting are retried with exponential backoff for up to bootstrapTimeout.
+func ensureDatabase(ctx context.Context, dsn string) error {
+	name, err := dsnDatabase(dsn)
+	if err != nil {
+		return err
+	}
+
+	maintenanceDSN, err := withDatabase(dsn, maintenanceDatabase)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, bootstrapTimeout)
+	defer cancel()
+
+	return retryUnavailable(ctx, bootstrapRetryPolicy, func(ctx context.Context) error {
+		err := pingDatabase(ctx, dsn)
+		if !isMissingDatabase(err) {
+			return err
+		}
+
+		return createDatabase(ctx, maintenanceDSN, name)
+	})
+}
+
+// pingDatabase checks that the database the DSN connects to accepts connections.
+func pingDatabase(ctx context.Context, dsn string) error {
+	db := openPostgres(dsn)
+	defer db.Close()
+
+	if err := db.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to connect to the database: %w", translateDBError(err))
+	}
+
+	return nil
+}
+
+// createDatabase creates the named database through the maintenance database the DSN connects to.
+// The database may have been created concurrently by another replica, which is not an error.
+func createDatabase(ctx context.Context, maintenanceDSN, name string) error {
+	db := openPostgres(maintenanceDSN)
+	defer db.Close()
+
+	_, err := db.NewRaw("CREATE DATABASE ?", bun.Ident(name)).Exec(ctx)
+	if err != nil && !isDuplicateDatabase(err) {
+		return fmt.Errorf("failed to create database %s: %w", name, translateDBError(err))
+	}
+
+	if err == nil {
+		klog.Infof("Database %s created.", name)
+	}
+
+	return nil
+}
+
+// isMissingDatabase reports whether the error was caused by connecting to a database that does not exist.
+func isMissingDatabase(err error) bool {
+	var pgErr pgdriver.Error
+
+	return errors.As(err, &pgErr) && pgErr.Field('C') == "3D000" // invalid_catalog_name
+}
+
+// isDuplicateDatabase reports whether the error was caused by creating a database that exists.
+func isDuplicateDatabase(err error) bool {
+	var pgErr pgdriver.Error
+
+	return errors.As(err, &pgErr) && pgErr.Field('C') == "42P04" // duplicate_database
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	ErrUsage            = errors.New("invalid usage")
+	ErrImportRowsFailed = errors.New("some rows were not imported")
+)
+
+// importChunkSize is the size of the roster chunks streamed by the import command.
+const importChunkSize = 64 << 10
+
+// command is a subcommand of the server binary, writing its output to out.
+type command func(ctx context.Context, args []string, out io.Writer) error
+
+// commands are the subcommands run instead of the server when the first argument names them.
+var commands = map[string]command{
+	"import":  runImportCommand,
+	"migrate": runMigrateCommand,
+}
+
+// runCommand runs the subcommand named by the first argument, if there is one, and exits with its outcome.
+// It returns without doing anything if the arguments name no subcommand.
+func runCommand(args []string) {
+	if len(args) == 0 {
+		return
+	}
+
+	run, ok := commands[args[0]]
+	if !ok {
+		return
+	}
+
+	// The environment variables may also be set without a .env file.
+	_ = godotenv.Load()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+
+	err := run(ctx, args[1:], os.Stdout)
+
+	stop()
+
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		os.Exit(0)
+	default:
+		fmt.Fprintf(os.Stderr, "%s: %v\n", args[0], err)
+		os.Exit(1)
+	}
+}
+
+// columnsFlag collects the repeated -column HEADER=FIELD flags of the import command.
+type columnsFlag map[string]string
+
+// String implements flag.Value.
+func (c columnsFlag) String() string {
+	columns := make([]string, 0, len(c))
+	for header, field := range c {
+		columns = append(columns, header+"="+field)
+	}
+
+	return strings.Join(columns, ",")
+}
+
+// Set implements flag.Value.
+func (c columnsFlag) Set(value string) error {
+	header, field, ok := strings.Cut(value, "=")
+	if !ok {
+		return fmt.Errorf("%w: %q is not HEADER=FIELD", ErrUsage, value)
+	}
+
+	c[header] = field
+
+	return nil
+}
+
+// importFormats maps the -format values, and the roster file extensions, to their format.
+var importFormats = map[string]spb.ImportFormat{
+	"csv":  spb.ImportFormat_IMPORT_FORMAT_CSV,
+	"xlsx": spb.ImportFormat_IMPORT_FORMAT_XLSX,
+}
+
+// importMatchBy maps the -match-by values to the field they match rows by.
+var importMatchBy = map[string]spb.ImportMatchBy{
+	"email":   spb.ImportMatchBy_IMPORT_MATCH_BY_EMAIL,
+	"staffID": spb.ImportMatchBy_IMPORT_MATCH_BY_STAFF_ID,
+}
+
+// runImportCommand streams a roster file to ImportStaffMembers and prints the outcome of every row.
+// It fails with ErrImportRowsFailed if some rows were not imported.
+func runImportCommand(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: server import [flags] ROSTER\n\nImports a CSV or XLSX roster of staff members.")
+		flags.PrintDefaults()
+	}
+
+	address := flags.String("addr", "localhost:"+os.Getenv("GRPC_PORT"), "address of the StaffServer")
+	token := flags.String("token", os.Getenv("STAFF_TOKEN"), "token of an admin or importer, $STAFF_TOKEN by default")
+	format := flags.String("format", "", "format of the roster, csv or xlsx, by default its file extension")
+	sheet := flags.String("sheet", "", "XLSX sheet to import, the first sheet by default")
+	matchBy := flags.String("match-by", "email", "field matching rows to existing staff members, email or staffID")
+	dryRun := flags.Bool("dry-run", false, "report the outcome of every row without importing anything")
+	columns := columnsFlag{}
+	flags.Var(columns, "column", "HEADER=FIELD mapping the column HEADER to a StaffMember field, may be repeated")
+
+	if err := flags.Parse(args); err != nil {
+		return err //nolint:wrapcheck // the flag package already printed the error
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+
+		return fmt.Errorf("%w: expected a single roster file", ErrUsage)
+	}
+
+	path := flags.Arg(0)
+
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	req := &spb.ImportStaffMembersRequest{Token: *token, Columns: columns, DryRun: *dryRun, Sheet: *sheet}
+
+	var ok bool
+	if req.Format, ok = importFormats[*format]; !ok {
+		return fmt.Errorf("%w: unknown format %q", ErrUsage, *format)
+	}
+
+	if req.MatchBy, ok = importMatchBy[*matchBy]; !ok {
+		return fmt.Errorf("%w: cannot match rows by %q", ErrUsage, *matchBy)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open roster: %w", err)
+	}
+	defer file.Close()
+
+	conn, err := grpc.NewClient(*address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", *address, err)
+	}
+	defer conn.Close()
+
+	report, err := importRoster(ctx, spb.NewStaffServiceClient(conn), req, file)
+	if err != nil {
+		return err
+	}
+
+	printImportReport(out, report)
+
+	if report.GetFailed() > 0 {
+		return fmt.Errorf("%w: %d failed", ErrImportRowsFailed, report.GetFailed())
+	}
+
+	return nil
+}
+
+// importRoster streams the roster to ImportStaffMembers in chunks, the first chunk being sent with the options of req.
+func importRoster(ctx context.Context, client spb.StaffServiceClient, req *spb.ImportStaffMembersRequest,
+	roster io.Reader,
+) (*spb.ImportStaffMembersResponse, error) {
+	stream, err := client.ImportStaffMembers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start import: %w", err)
+	}
+
+	for {
+		// Sent messages may still be read after Send returns, so every chunk has its own buffer.
+		chunk := make([]byte, importChunkSize)
+
+		n, readErr := io.ReadFull(roster, chunk)
+		if readErr != nil && !errors.Is(readErr, io.EOF) && !errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("failed to read roster: %w", readErr)
+		}
+
+		req.Chunk = chunk[:n]
+		if err := stream.Send(req); err != nil {
+			// The server ended the stream, and CloseAndRecv returns why.
+			break
+		}
+
+		if readErr != nil {
+			break
+		}
+
+		req = &spb.ImportStaffMembersRequest{}
+	}
+
+	report, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to import roster: %w", err)
+	}
+
+	return report, nil
+}
+
+// printImportReport prints the outcome of every row, followed by the number of rows of each outcome.
+func printImportReport(out io.Writer, report *spb.ImportStaffMembersResponse) {
+	for _, row := range report.GetRows() {
+		line := fmt.Sprintf("row %d: %s", row.GetRow(),
+			strings.ToLower(strings.TrimPrefix(row.GetStatus().String(), "IMPORT_ROW_")))
+		if row.GetStaffID() != "" {
+			line += " " + row.GetStaffID()
+		}
+
+		if row.GetMessage() != "" {
+			line += ": " + row.GetMessage()
+		}
+
+		fmt.Fprintln(out, line)
+	}
+
+	fmt.Fprintf(out, "created %d, updated %d, skipped %d, failed %d\n",
+		report.GetCreated(), report.GetUpdated(), report.GetSkipped(), report.GetFailed())
+
+	if report.GetDryRun() {
+		fmt.Fprintln(out, "dry run: nothing was imported")
+	}
+}
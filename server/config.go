@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+var ErrInvalidDatabaseConfig = errors.New("invalid database configuration")
+
+const (
+	// readInitialBackoff and readMaxBackoff bound the wait before retrying a read of an unavailable database.
+	readInitialBackoff = 50 * time.Millisecond
+	readMaxBackoff     = time.Second
+)
+
+// DatabaseConfig configures the connection pool of the Database and the limits of its calls.
+type DatabaseConfig struct {
+	// MaxOpenConns caps the connections open to PostgreSQL, 0 meaning no limit.
+	MaxOpenConns int
+	// MaxIdleConns is the number of idle connections kept open for reuse.
+	MaxIdleConns int
+	// ConnMaxLifetime closes connections after this long, so that they are spread again over the database replicas.
+	ConnMaxLifetime time.Duration
+	// ConnMaxIdleTime closes connections that were idle for this long.
+	ConnMaxIdleTime time.Duration
+	// QueryTimeout bounds every Database call, unless the context has an earlier deadline. 0 disables it.
+	QueryTimeout time.Duration
+	// ReadRetries is the number of times reads failing with ErrDatabaseUnavailable are retried.
+	ReadRetries int
+}
+
+// defaultDatabaseConfig is the configuration used for the environment variables that are not set.
+var defaultDatabaseConfig = DatabaseConfig{
+	MaxOpenConns:    20,
+	MaxIdleConns:    10,
+	ConnMaxLifetime: 30 * time.Minute,
+	ConnMaxIdleTime: 5 * time.Minute,
+	QueryTimeout:    10 * time.Second,
+	ReadRetries:     2,
+}
+
+// LoadDatabaseConfig reads the DatabaseConfig from the DB_MAX_OPEN_CONNS, DB_MAX_IDLE_CONNS,
+// DB_CONN_MAX_LIFETIME, DB_CONN_MAX_IDLE_TIME, DB_QUERY_TIMEOUT and DB_READ_RETRIES environment variables.
+// Durations are written as in "30s" or "5m".
+func LoadDatabaseConfig() (DatabaseConfig, error) {
+	config := defaultDatabaseConfig
+
+	ints := map[string]*int{
+		"DB_MAX_OPEN_CONNS": &config.MaxOpenConns,
+		"DB_MAX_IDLE_CONNS": &config.MaxIdleConns,
+		"DB_READ_RETRIES":   &config.ReadRetries,
+	}
+
+	for name, field := range ints {
+		value, ok := os.LookupEnv(name)
+		if !ok || value == "" {
+			continue
+		}
+
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return DatabaseConfig{}, fmt.Errorf("%w: %s must be a non-negative integer, got %q",
+				ErrInvalidDatabaseConfig, name, value)
+		}
+
+		*field = parsed
+	}
+
+	durations := map[string]*time.Duration{
+		"DB_CONN_MAX_LIFETIME":  &config.ConnMaxLifetime,
+		"DB_CONN_MAX_IDLE_TIME": &config.ConnMaxIdleTime,
+		"DB_QUERY_TIMEOUT":      &config.QueryTimeout,
+	}
+
+	for name, field := range durations {
+		value, ok := os.LookupEnv(name)
+		if !ok || value == "" {
+			continue
+		}
+
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed < 0 {
+			return DatabaseConfig{}, fmt.Errorf("%w: %s must be a non-negative duration, got %q",
+				ErrInvalidDatabaseConfig, name, value)
+		}
+
+		*field = parsed
+	}
+
+	return config, nil
+}
+
+// readRetryPolicy returns the policy of retrying reads of an unavailable database.
+func (c DatabaseConfig) readRetryPolicy() retryPolicy {
+	return retryPolicy{attempts: c.ReadRetries + 1, initialBackoff: readInitialBackoff, maxBackoff: readMaxBackoff}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"time"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// staffMemberFromProto converts the client-provided fields of a StaffMember message to a staff_members row.
+// Server-managed fields, such as the timestamps, actors and etag, are ignored.
+func staffMemberFromProto(staff *spb.StaffMember) *StaffMember {
+	return &StaffMember{
+		StaffID:     staff.GetStaffID(),
+		FirstName:   staff.GetFirstName(),
+		LastName:    staff.GetLastName(),
+		Email:       staff.GetEmail(),
+		PhoneNumber: staff.GetPhoneNumber(),
+		Title:       staff.GetTitle(),
+		Office:      staff.GetOffice(),
+		Subject:     staff.GetSubject(),
+	}
+}
+
+// staffMemberToProto converts a staff_members row to its protobuf representation.
+func staffMemberToProto(staff *StaffMember) *spb.StaffMember {
+	return &spb.StaffMember{
+		StaffID:     staff.StaffID,
+		FirstName:   staff.FirstName,
+		LastName:    staff.LastName,
+		Email:       staff.Email,
+		PhoneNumber: staff.PhoneNumber,
+		Title:       staff.Title,
+		Office:      staff.Office,
+		DeletedAt:   optionalTimestamp(staff.DeletedAt),
+		Etag:        staff.ETag(),
+		CreatedAt:   optionalTimestamp(staff.CreatedAt),
+		UpdatedAt:   optionalTimestamp(staff.UpdatedAt),
+		CreatedBy:   staff.CreatedBy,
+		UpdatedBy:   staff.UpdatedBy,
+		Subject:     staff.Subject,
+	}
+}
+
+// auditEventToProto converts a staff_audit_log row to its protobuf representation.
+func auditEventToProto(event *StaffAuditEvent) *spb.StaffAuditEvent {
+	return &spb.StaffAuditEvent{
+		EventID:   event.EventID,
+		StaffID:   event.StaffID,
+		Action:    spb.StaffAuditAction(spb.StaffAuditAction_value["AUDIT_ACTION_"+string(event.Action)]),
+		Rpc:       event.RPC,
+		Actor:     event.Actor,
+		RequestID: event.RequestID,
+		Before:    event.Before,
+		After:     event.After,
+		CreatedAt: optionalTimestamp(event.CreatedAt),
+	}
+}
+
+// optionalTime converts a protobuf timestamp to a time, leaving unset timestamps zero.
+func optionalTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+// optionalTimestamp converts a time to a protobuf timestamp, leaving zero times unset.
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
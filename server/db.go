@@ -2,111 +2,215 @@ package main
 
 import (
 	"context"
-	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	spb "github.com/BetterGR/staff-microservice/protos"
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
-	"github.com/uptrace/bun/dialect/pgdialect"
-	"github.com/uptrace/bun/driver/pgdriver"
 	"k8s.io/klog/v2"
 )
 
-// Database represents the PostgreSQL database connection.
+// Database represents the connection to a PostgreSQL or SQLite database.
 type Database struct {
-	db *bun.DB
+	db     *bun.DB
+	config DatabaseConfig
 }
 
 var (
-	ErrStaffMemberNil      = errors.New("staff member is nil")
-	ErrStaffMemberIDEmpty  = errors.New("staff member ID is empty")
-	ErrStaffMemberNotFound = errors.New("staff member not found")
+	ErrStaffMemberNil        = errors.New("staff member is nil")
+	ErrStaffMemberIDEmpty    = errors.New("staff member ID is empty")
+	ErrStaffMemberNotFound   = errors.New("staff member not found")
+	ErrStaffMemberNotDeleted = errors.New("staff member is not deleted")
+	ErrInvalidUpdateMask     = errors.New("invalid update mask")
+	ErrStaleEtag             = errors.New("staff member was modified since the etag was read")
+	ErrInvalidPageToken      = errors.New("invalid page token")
+	ErrInvalidPageSize       = errors.New("invalid page size")
+	ErrInvalidOrderBy        = errors.New("invalid order by")
+	ErrSearchQueryEmpty      = errors.New("search query is empty")
 )
 
-// InitializeDatabase ensures that the database exists and initializes the schema.
+const (
+	// defaultPageSize is used when the caller does not ask for a page size.
+	defaultPageSize = 50
+	// maxPageSize caps the number of staff members returned in a single page.
+	maxPageSize = 1000
+	// defaultSearchLimit is used when the caller does not ask for a number of search results.
+	defaultSearchLimit = 20
+	// maxSearchLimit caps the number of search results.
+	maxSearchLimit = 100
+	// searchSimilarityThreshold is the minimal trigram word similarity of a fuzzy match.
+	searchSimilarityThreshold = 0.3
+)
+
+// staffSearchDocument is the normalized text staff members are searched by.
+// It must match the expression of the staff_members_search_idx index created by the migrations.
+const staffSearchDocument = "staff_search_normalize(first_name || ' ' || last_name || ' ' || email || ' ' || " +
+	"coalesce(office, ''))"
+
+// InitializeDatabase creates the database if it does not exist, connects to it and migrates the schema.
+// The scheme of the DSN environment variable selects the database: an in-memory SQLite database for "memory:",
+// a SQLite database file for "sqlite:", and PostgreSQL otherwise.
 func InitializeDatabase() (*Database, error) {
-	createDatabaseIfNotExists()
+	ctx := context.Background()
 
-	database, err := ConnectDB()
+	database, err := OpenDatabase(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := database.createSchemaIfNotExists(context.Background()); err != nil {
-		klog.Fatalf("Failed to create schema: %v", err)
+	if err := database.migrateSchema(ctx); err != nil {
+		database.db.Close()
+
+		return nil, err
 	}
 
 	return database, nil
 }
 
-// createDatabaseIfNotExists ensures the database exists.
-func createDatabaseIfNotExists() {
-	dsn := os.Getenv("DSN")
-	connector := pgdriver.NewConnector(pgdriver.WithDSN(dsn))
-
-	sqldb := sql.OpenDB(connector)
-	defer sqldb.Close()
-
-	ctx := context.Background()
-	dbName := os.Getenv("DP_NAME")
-	query := "SELECT 1 FROM pg_database WHERE datname = $1;"
-
-	var exists int
-
-	err := sqldb.QueryRowContext(ctx, query, dbName).Scan(&exists)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		klog.Fatalf("Failed to check db existence: %v", err)
+// OpenDatabase creates the database if it does not exist and connects to it with the configuration of the environment.
+func OpenDatabase(ctx context.Context) (*Database, error) {
+	config, err := LoadDatabaseConfig()
+	if err != nil {
+		return nil, err
 	}
 
-	if errors.Is(err, sql.ErrNoRows) {
-		if _, err = sqldb.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE %s;", dbName)); err != nil {
-			klog.Fatalf("Failed to create database: %v", err)
+	// A SQLite database file is created by the first connection to it.
+	if dsn := os.Getenv("DSN"); !isSQLiteDSN(dsn) && !isMemoryDSN(dsn) {
+		dsn, err := databaseDSN()
+		if err != nil {
+			return nil, err
 		}
 
-		klog.V(logLevelDebug).Infof("Database %s created successfully.", dbName)
-	} else {
-		klog.V(logLevelDebug).Infof("Database %s already exists.", dbName)
+		if err := ensureDatabase(ctx, dsn); err != nil {
+			return nil, err
+		}
 	}
+
+	return ConnectDB(config)
 }
 
 // ConnectDB connects to the database.
-func ConnectDB() (*Database, error) {
-	dsn := os.Getenv("DSN")
-	connector := pgdriver.NewConnector(pgdriver.WithDSN(dsn))
-	sqldb := sql.OpenDB(connector)
-	database := bun.NewDB(sqldb, pgdialect.New())
+func ConnectDB(config DatabaseConfig) (*Database, error) {
+	database, err := openDB()
+	if err != nil {
+		return nil, err
+	}
+
+	// An in-memory database lives as long as the connection that created it.
+	if isMemoryDSN(os.Getenv("DSN")) {
+		config.MaxOpenConns, config.MaxIdleConns = 1, 1
+		config.ConnMaxLifetime, config.ConnMaxIdleTime = 0, 0
+	}
+
+	database.SetMaxOpenConns(config.MaxOpenConns)
+	database.SetMaxIdleConns(config.MaxIdleConns)
+	database.SetConnMaxLifetime(config.ConnMaxLifetime)
+	database.SetConnMaxIdleTime(config.ConnMaxIdleTime)
+	database.AddQueryHook(newTracingQueryHook(database.Dialect().Name()))
 
 	// Test the connection.
 	if err := database.Ping(); err != nil {
-		return nil, fmt.Errorf("failed to connect to the database: %w", err)
+		database.Close()
+
+		return nil, fmt.Errorf("failed to connect to the database: %w", translateDBError(err))
 	}
 
-	klog.V(logLevelDebug).Info("Connected to PostgreSQL database.")
+	klog.V(logLevelDebug).Infof("Connected to %s database.", database.Dialect().Name())
 
-	return &Database{db: database}, nil
+	return &Database{db: database, config: config}, nil
 }
 
-// createSchemaIfNotExists creates the database schema if it doesn't exist.
-func (d *Database) createSchemaIfNotExists(ctx context.Context) error {
-	models := []interface{}{
-		(*StaffMember)(nil),
+// Ping checks that the database can be reached, within the query timeout.
+func (d *Database) Ping(ctx context.Context) error {
+	ctx, cancel := d.withQueryTimeout(ctx)
+	defer cancel()
+
+	if err := d.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to ping the database: %w", translateDBError(err))
 	}
 
-	for _, model := range models {
-		if _, err := d.db.NewCreateTable().IfNotExists().Model(model).Exec(ctx); err != nil {
-			return fmt.Errorf("failed to create table: %w", err)
-		}
+	return nil
+}
+
+// Close closes the connections to the database.
+func (d *Database) Close() error {
+	if err := d.db.Close(); err != nil {
+		return fmt.Errorf("failed to close database: %w", err)
 	}
 
-	klog.V(logLevelDebug).Info("Database schema initialized.")
+	return nil
+}
+
+// withQueryTimeout bounds the context of a Database call by the query timeout.
+func (d *Database) withQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if d.config.QueryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, d.config.QueryTimeout)
+}
+
+// read runs an idempotent read within the query timeout, retrying it with jittered backoff
+// while it fails with ErrDatabaseUnavailable.
+func (d *Database) read(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, cancel := d.withQueryTimeout(ctx)
+	defer cancel()
+
+	return retryUnavailable(ctx, d.config.readRetryPolicy(), fn)
+}
+
+// runInTx runs fn in a transaction, which is committed if fn succeeds and rolled back otherwise,
+// within the query timeout. The errors of fn are returned as they are.
+func (d *Database) runInTx(ctx context.Context, fn func(ctx context.Context, tx bun.Tx) error) error {
+	ctx, cancel := d.withQueryTimeout(ctx)
+	defer cancel()
+
+	return d.runInLongTx(ctx, fn)
+}
+
+// runInLongTx is runInTx without the query timeout, for transactions whose duration grows with their input.
+func (d *Database) runInLongTx(ctx context.Context, fn func(ctx context.Context, tx bun.Tx) error) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", translateDBError(err))
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(ctx, tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", translateDBError(err))
+	}
 
 	return nil
 }
 
+// recordChange records a change to a staff member within the transaction making the change,
+// both in the audit log and, if other services are told about it, in the outbox.
+// before is nil for created staff members and after is nil for purged ones.
+func recordChange(ctx context.Context, tx bun.IDB, action auditAction, before, after *StaffMember) error {
+	if err := recordAuditEvent(ctx, tx, action, before, after); err != nil {
+		return err
+	}
+
+	return enqueueStaffEvent(ctx, tx, action, after)
+}
+
 // StaffMember represents the staff_members table.
+// Deleted staff members are kept with DeletedAt set, and bun hides them from queries
+// unless they explicitly ask for deleted rows.
+// Version is incremented, and UpdatedAt and UpdatedBy set, by every write to the row.
+// CreatedBy and UpdatedBy hold the token subjects of the actors recorded in the request context.
 type StaffMember struct {
 	StaffID     string    `bun:"staff_id,unique,pk,notnull"`
 	FirstName   string    `bun:"first_name,notnull"`
@@ -115,49 +219,232 @@ type StaffMember struct {
 	PhoneNumber string    `bun:"phone_number,unique,notnull"`
 	Title       string    `bun:"title"`
 	Office      string    `bun:"office"`
+	Subject     string    `bun:"subject,nullzero"`
 	CreatedAt   time.Time `bun:"created_at,default:current_timestamp"`
 	UpdatedAt   time.Time `bun:"updated_at,default:current_timestamp"`
+	CreatedBy   string    `bun:"created_by"`
+	UpdatedBy   string    `bun:"updated_by"`
+	DeletedAt   time.Time `bun:"deleted_at,soft_delete,nullzero"`
+	Version     int64     `bun:"version,notnull,default:1"`
+}
+
+// ETag returns the opaque entity tag identifying the current version of the staff member.
+func (s *StaffMember) ETag() string {
+	return strconv.FormatInt(s.Version, 10)
+}
+
+// newStaffID returns a time-ordered UUIDv7 identifier for a new staff member.
+func newStaffID() (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate staff ID: %w", err)
+	}
+
+	return id.String(), nil
 }
 
 // AddStaffMember adds a new staff member.
+// A staffID is generated if the staff member does not have one.
 func (d *Database) AddStaffMember(ctx context.Context, staff *spb.StaffMember) (*StaffMember, error) {
+	var created *StaffMember
+
+	err := d.runInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		created, err = addStaffMember(ctx, tx, staff)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// addStaffMember adds a new staff member within a transaction.
+func addStaffMember(ctx context.Context, tx bun.Tx, staff *spb.StaffMember) (*StaffMember, error) {
 	if staff == nil {
 		return nil, fmt.Errorf("%w", ErrStaffMemberNil)
 	}
 
-	newStaffMember := &StaffMember{
-		StaffID:     staff.GetStaffID(),
-		FirstName:   staff.GetFirstName(),
-		LastName:    staff.GetLastName(),
-		Email:       staff.GetEmail(),
-		PhoneNumber: staff.GetPhoneNumber(),
-		Title:       staff.GetTitle(),
-		Office:      staff.GetOffice(),
+	newStaffMember, err := newStaffMemberRow(ctx, staff)
+	if err != nil {
+		return nil, err
 	}
 
-	if _, err := d.db.NewInsert().Model(newStaffMember).Exec(ctx); err != nil {
-		return nil, fmt.Errorf("failed to add staff member: %w", err)
+	if _, err := tx.NewInsert().Model(newStaffMember).Returning("*").Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to add staff member: %w", translateDBError(err))
+	}
+
+	if err := recordChange(ctx, tx, auditActionCreate, nil, newStaffMember); err != nil {
+		return nil, err
 	}
 
 	return newStaffMember, nil
 }
 
+// newStaffMemberRow returns the staff_members row inserted for a new staff member.
+// A staffID is generated if the staff member does not have one.
+func newStaffMemberRow(ctx context.Context, staff *spb.StaffMember) (*StaffMember, error) {
+	staffID := staff.GetStaffID()
+	if staffID == "" {
+		var err error
+		if staffID, err = newStaffID(); err != nil {
+			return nil, err
+		}
+	}
+
+	row := staffMemberFromProto(staff)
+	row.StaffID = staffID
+	row.Version = 1
+	row.CreatedBy = actorFromContext(ctx)
+	row.UpdatedBy = row.CreatedBy
+	// The timestamps are set here rather than defaulted by the database, as SQLite
+	// would store current_timestamp in a format that does not compare with the others.
+	row.CreatedAt = time.Now()
+	row.UpdatedAt = row.CreatedAt
+
+	return row, nil
+}
+
+// GetStaffMemberBySubject retrieves the staff member whose own account has the given token subject.
+// Deleted staff members are not returned.
+func (d *Database) GetStaffMemberBySubject(ctx context.Context, subject string) (*StaffMember, error) {
+	if subject == "" {
+		return nil, fmt.Errorf("%w", ErrStaffMemberNotFound)
+	}
+
+	staffMember := new(StaffMember)
+
+	err := d.read(ctx, func(ctx context.Context) error {
+		if err := d.db.NewSelect().Model(staffMember).Where("subject = ?", subject).Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get staff member: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return staffMember, nil
+}
+
 // GetStaffMember retrieves a staff member by ID.
-func (d *Database) GetStaffMember(ctx context.Context, staffID string) (*StaffMember, error) {
+// Deleted staff members are only returned if includeDeleted is set.
+func (d *Database) GetStaffMember(ctx context.Context, staffID string, includeDeleted bool) (*StaffMember, error) {
 	if staffID == "" {
 		return nil, fmt.Errorf("%w", ErrStaffMemberIDEmpty)
 	}
 
 	staffMember := new(StaffMember)
-	if err := d.db.NewSelect().Model(staffMember).Where("staff_id = ?", staffID).Scan(ctx); err != nil {
-		return nil, fmt.Errorf("failed to get staff member: %w", err)
+
+	err := d.read(ctx, func(ctx context.Context) error {
+		query := d.db.NewSelect().Model(staffMember).Where("staff_id = ?", staffID)
+		if includeDeleted {
+			query = query.WhereAllWithDeleted()
+		}
+
+		if err := query.Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get staff member: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return staffMember, nil
 }
 
+// updatableStaffMemberFields maps the update mask paths to the staff member fields they set.
+var updatableStaffMemberFields = map[string]func(model *StaffMember, staff *spb.StaffMember){
+	"firstName":   func(model *StaffMember, staff *spb.StaffMember) { model.FirstName = staff.GetFirstName() },
+	"lastName":    func(model *StaffMember, staff *spb.StaffMember) { model.LastName = staff.GetLastName() },
+	"email":       func(model *StaffMember, staff *spb.StaffMember) { model.Email = staff.GetEmail() },
+	"phoneNumber": func(model *StaffMember, staff *spb.StaffMember) { model.PhoneNumber = staff.GetPhoneNumber() },
+	"title":       func(model *StaffMember, staff *spb.StaffMember) { model.Title = staff.GetTitle() },
+	"office":      func(model *StaffMember, staff *spb.StaffMember) { model.Office = staff.GetOffice() },
+	"subject":     func(model *StaffMember, staff *spb.StaffMember) { model.Subject = staff.GetSubject() },
+}
+
+// validateUpdateMask checks that every path of the update mask is an updatable field.
+func validateUpdateMask(updateMask []string) error {
+	for _, path := range updateMask {
+		if _, ok := updatableStaffMemberFields[path]; !ok {
+			return fmt.Errorf("%w: unknown field %q", ErrInvalidUpdateMask, path)
+		}
+	}
+
+	return nil
+}
+
+// changesStaffMember reports whether setting the masked fields of staff would change the existing staff member.
+func changesStaffMember(existing *StaffMember, staff *spb.StaffMember, updateMask []string) bool {
+	updated := *existing
+	for _, path := range updateMask {
+		updatableStaffMemberFields[path](&updated, staff)
+	}
+
+	return updated != *existing
+}
+
+// applyStaffMemberUpdate sets the fields of an update on a staff member.
+// If updateMask is empty, the non-empty fields of staff are set; otherwise exactly the masked fields are.
+func applyStaffMemberUpdate(row *StaffMember, staff *spb.StaffMember, updateMask []string) {
+	if len(updateMask) > 0 {
+		// Set exactly the masked fields.
+		for _, path := range updateMask {
+			updatableStaffMemberFields[path](row, staff)
+		}
+
+		return
+	}
+
+	// Update the non-empty fields.
+	updateField := func(field *string, newValue string) {
+		if newValue != "" {
+			*field = newValue
+		}
+	}
+
+	updateField(&row.FirstName, staff.GetFirstName())
+	updateField(&row.LastName, staff.GetLastName())
+	updateField(&row.Email, staff.GetEmail())
+	updateField(&row.PhoneNumber, staff.GetPhoneNumber())
+	updateField(&row.Title, staff.GetTitle())
+	updateField(&row.Office, staff.GetOffice())
+	updateField(&row.Subject, staff.GetSubject())
+}
+
 // UpdateStaffMember updates an existing staff member.
-func (d *Database) UpdateStaffMember(ctx context.Context, staff *spb.StaffMember) (*StaffMember, error) {
+// If updateMask is empty, the non-empty fields of staff are updated.
+// Otherwise exactly the fields listed in updateMask are set, clearing them if they are empty in staff.
+// If etag is set, the update fails with ErrStaleEtag unless it identifies the current version.
+// The update also fails with ErrStaleEtag if the staff member is modified concurrently.
+func (d *Database) UpdateStaffMember(ctx context.Context, staff *spb.StaffMember,
+	updateMask []string, etag string,
+) (*StaffMember, error) {
+	var updated *StaffMember
+
+	err := d.runInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		updated, err = updateStaffMember(ctx, tx, staff, updateMask, etag)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// updateStaffMember updates an existing staff member within a transaction.
+func updateStaffMember(ctx context.Context, tx bun.Tx, staff *spb.StaffMember,
+	updateMask []string, etag string,
+) (*StaffMember, error) {
 	if staff == nil {
 		return nil, fmt.Errorf("%w", ErrStaffMemberNil)
 	}
@@ -166,47 +453,480 @@ func (d *Database) UpdateStaffMember(ctx context.Context, staff *spb.StaffMember
 		return nil, fmt.Errorf("%w", ErrStaffMemberIDEmpty)
 	}
 
+	if err := validateUpdateMask(updateMask); err != nil {
+		return nil, err
+	}
+
 	// get the existing staff member
 	existingStaffMember := &StaffMember{StaffID: staff.GetStaffID()}
-	if err := d.db.NewSelect().Model(existingStaffMember).WherePK().Scan(ctx); err != nil {
-		return nil, fmt.Errorf("failed to get staff member: %w", err)
+	if err := tx.NewSelect().Model(existingStaffMember).WherePK().Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get staff member: %w", translateDBError(err))
 	}
 
-	// Update the fields.
-	updateField := func(field *string, newValue string) {
-		if newValue != "" {
-			*field = newValue
-		}
+	if etag != "" && etag != existingStaffMember.ETag() {
+		return nil, fmt.Errorf("%w", ErrStaleEtag)
 	}
 
-	updateField(&existingStaffMember.FirstName, staff.GetFirstName())
-	updateField(&existingStaffMember.LastName, staff.GetLastName())
-	updateField(&existingStaffMember.Email, staff.GetEmail())
-	updateField(&existingStaffMember.PhoneNumber, staff.GetPhoneNumber())
-	updateField(&existingStaffMember.Title, staff.GetTitle())
-	updateField(&existingStaffMember.Office, staff.GetOffice())
+	before := *existingStaffMember
+	readVersion := existingStaffMember.Version
+
+	applyStaffMemberUpdate(existingStaffMember, staff, updateMask)
+
+	existingStaffMember.Version = readVersion + 1
+	existingStaffMember.UpdatedAt = time.Now()
+	existingStaffMember.UpdatedBy = actorFromContext(ctx)
+
+	// Only write the row if nobody else wrote it since it was read.
+	res, err := tx.NewUpdate().Model(existingStaffMember).WherePK().Where("version = ?", readVersion).Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update staff member: %w", translateDBError(err))
+	}
+
+	if num, _ := res.RowsAffected(); num == 0 {
+		return nil, fmt.Errorf("%w", ErrStaleEtag)
+	}
 
-	if _, err := d.db.NewUpdate().Model(existingStaffMember).WherePK().Exec(ctx); err != nil {
-		return nil, fmt.Errorf("failed to update staff member: %w", err)
+	if err := recordChange(ctx, tx, auditActionUpdate, &before, existingStaffMember); err != nil {
+		return nil, err
 	}
 
 	return existingStaffMember, nil
 }
 
-// DeleteStaffMember deletes a staff member by ID.
-func (d *Database) DeleteStaffMember(ctx context.Context, id string) error {
+// DeleteStaffMember marks a staff member as deleted.
+// If etag is set, the deletion fails with ErrStaleEtag unless it identifies the current version.
+func (d *Database) DeleteStaffMember(ctx context.Context, id, etag string) error {
+	return d.runInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		return deleteStaffMember(ctx, tx, id, etag)
+	})
+}
+
+// deleteStaffMember marks a staff member as deleted within a transaction.
+func deleteStaffMember(ctx context.Context, tx bun.Tx, id, etag string) error {
 	if id == "" {
 		return fmt.Errorf("%w", ErrStaffMemberIDEmpty)
 	}
 
-	res, err := d.db.NewDelete().Model((*StaffMember)(nil)).Where("staff_id = ?", id).Exec(ctx)
+	// The row is read before it is updated, so that the audit event records its version before the deletion.
+	before := new(StaffMember)
+	if err := forUpdate(tx.NewSelect().Model(before).Where("staff_id = ?", id)).Scan(ctx); err != nil {
+		return fmt.Errorf("failed to delete staff member: %w", translateDBError(err))
+	}
+
+	if etag != "" && etag != before.ETag() {
+		return fmt.Errorf("%w", ErrStaleEtag)
+	}
+
+	deleted := new(StaffMember)
+	now := time.Now()
+
+	res, err := tx.NewUpdate().
+		Model(deleted).
+		Set("deleted_at = ?", now).
+		Set("updated_at = ?", now).
+		Set("updated_by = ?", actorFromContext(ctx)).
+		Set("version = version + 1").
+		Where("staff_id = ?", id).
+		Where("version = ?", before.Version).
+		Returning("*").
+		Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete staff member: %w", err)
+		return fmt.Errorf("failed to delete staff member: %w", translateDBError(err))
 	}
 
 	if num, _ := res.RowsAffected(); num == 0 {
+		return fmt.Errorf("%w", ErrStaleEtag)
+	}
+
+	return recordChange(ctx, tx, auditActionDelete, before, deleted)
+}
+
+// RestoreStaffMember clears the deletion mark of a deleted staff member.
+func (d *Database) RestoreStaffMember(ctx context.Context, id string) (*StaffMember, error) {
+	if id == "" {
+		return nil, fmt.Errorf("%w", ErrStaffMemberIDEmpty)
+	}
+
+	var restored *StaffMember
+
+	err := d.runInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		deleted := new(StaffMember)
+		if err := tx.NewSelect().Model(deleted).Where("staff_id = ?", id).WhereDeleted().Scan(ctx); err != nil {
+			return fmt.Errorf("failed to restore staff member: %w", translateDBError(err))
+		}
+
+		restored = new(StaffMember)
+
+		res, err := tx.NewUpdate().
+			Model(restored).
+			Set("deleted_at = NULL").
+			Set("updated_at = ?", time.Now()).
+			Set("updated_by = ?", actorFromContext(ctx)).
+			Set("version = version + 1").
+			Where("staff_id = ?", id).
+			Where("version = ?", deleted.Version).
+			WhereDeleted().
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to restore staff member: %w", translateDBError(err))
+		}
+
+		if num, _ := res.RowsAffected(); num == 0 {
+			return fmt.Errorf("%w", ErrStaffMemberNotFound)
+		}
+
+		return recordChange(ctx, tx, auditActionRestore, deleted, restored)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return restored, nil
+}
+
+// PurgeStaffMember permanently removes a deleted staff member.
+// Staff members that are not deleted must be deleted before they can be purged.
+func (d *Database) PurgeStaffMember(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("%w", ErrStaffMemberIDEmpty)
+	}
+
+	return d.runInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		purged := new(StaffMember)
+
+		res, err := tx.NewDelete().
+			Model(purged).
+			Where("staff_id = ?", id).
+			WhereDeleted().
+			ForceDelete().
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to purge staff member: %w", translateDBError(err))
+		}
+
+		if num, _ := res.RowsAffected(); num > 0 {
+			return recordChange(ctx, tx, auditActionPurge, purged, nil)
+		}
+
+		exists, err := tx.NewSelect().Model((*StaffMember)(nil)).Where("staff_id = ?", id).Exists(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to purge staff member: %w", translateDBError(err))
+		}
+
+		if exists {
+			return fmt.Errorf("%w", ErrStaffMemberNotDeleted)
+		}
+
 		return fmt.Errorf("%w", ErrStaffMemberNotFound)
+	})
+}
+
+// StaffMemberOrder is the column used to sort staff member listings.
+type StaffMemberOrder string
+
+const (
+	OrderByLastName  StaffMemberOrder = "last_name"
+	OrderByCreatedAt StaffMemberOrder = "created_at"
+)
+
+// StaffMemberFilter narrows down the staff members returned by listing queries.
+// Empty fields are not filtered on.
+type StaffMemberFilter struct {
+	Title          string
+	Office         string
+	IncludeDeleted bool
+}
+
+// ListStaffMembersParams holds the pagination, filtering and ordering options of ListStaffMembers.
+type ListStaffMembersParams struct {
+	Filter     StaffMemberFilter
+	OrderBy    StaffMemberOrder
+	Descending bool
+	PageSize   int
+	PageToken  string
+}
+
+// StaffMemberPage is a single page of staff members.
+type StaffMemberPage struct {
+	StaffMembers  []*StaffMember
+	NextPageToken string
+	TotalCount    int
+}
+
+// pageCursor is the decoded form of a page token.
+// It holds the sort key of the last staff member of the previous page.
+type pageCursor struct {
+	OrderBy    StaffMemberOrder `json:"o"`
+	Descending bool             `json:"d"`
+	LastName   string           `json:"l,omitempty"`
+	CreatedAt  time.Time        `json:"c,omitempty"`
+	StaffID    string           `json:"i"`
+}
+
+// encodePageToken turns the last staff member of a page into an opaque page token.
+func encodePageToken(params *ListStaffMembersParams, last *StaffMember) (string, error) {
+	cursor := pageCursor{
+		OrderBy:    params.OrderBy,
+		Descending: params.Descending,
+		StaffID:    last.StaffID,
 	}
 
-	return nil
+	switch params.OrderBy {
+	case OrderByLastName:
+		cursor.LastName = last.LastName
+	case OrderByCreatedAt:
+		cursor.CreatedAt = last.CreatedAt
+	}
+
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodePageToken parses a page token and checks it was issued for the same ordering.
+func decodePageToken(params *ListStaffMembersParams) (*pageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(params.PageToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w", ErrInvalidPageToken)
+	}
+
+	cursor := new(pageCursor)
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("%w", ErrInvalidPageToken)
+	}
+
+	if cursor.OrderBy != params.OrderBy || cursor.Descending != params.Descending || cursor.StaffID == "" {
+		return nil, fmt.Errorf("%w: ordering does not match the previous request", ErrInvalidPageToken)
+	}
+
+	return cursor, nil
+}
+
+// normalizeListParams validates the listing parameters and fills in the defaults.
+func normalizeListParams(params *ListStaffMembersParams) (*ListStaffMembersParams, error) {
+	normalized := *params
+
+	if normalized.PageSize < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidPageSize, normalized.PageSize)
+	}
+
+	if normalized.PageSize == 0 {
+		normalized.PageSize = defaultPageSize
+	}
+
+	normalized.PageSize = min(normalized.PageSize, maxPageSize)
+
+	switch normalized.OrderBy {
+	case "":
+		normalized.OrderBy = OrderByLastName
+	case OrderByLastName, OrderByCreatedAt:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOrderBy, normalized.OrderBy)
+	}
+
+	return &normalized, nil
+}
+
+// applyStaffMemberFilter adds the filter conditions to a staff_members query.
+func applyStaffMemberFilter(query *bun.SelectQuery, filter StaffMemberFilter) *bun.SelectQuery {
+	if filter.Title != "" {
+		query = query.Where("title = ?", filter.Title)
+	}
+
+	if filter.Office != "" {
+		query = query.Where("office = ?", filter.Office)
+	}
+
+	if filter.IncludeDeleted {
+		query = query.WhereAllWithDeleted()
+	}
+
+	return query
+}
+
+// ListStaffMembers returns a page of staff members using keyset pagination.
+// Staff members are sorted by the requested column, with staff_id breaking ties,
+// so that page tokens remain stable while rows are inserted or deleted.
+func (d *Database) ListStaffMembers(ctx context.Context, params *ListStaffMembersParams) (*StaffMemberPage, error) {
+	params, err := normalizeListParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int
+
+	err = d.read(ctx, func(ctx context.Context) error {
+		var err error
+		if total, err = applyStaffMemberFilter(d.db.NewSelect().Model((*StaffMember)(nil)), params.Filter).
+			Count(ctx); err != nil {
+			return fmt.Errorf("failed to count staff members: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := d.listStaffMembers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	page.TotalCount = total
+
+	return page, nil
+}
+
+// listStaffMembers returns a page of staff members, without counting them, for normalized params.
+func (d *Database) listStaffMembers(ctx context.Context, params *ListStaffMembersParams) (*StaffMemberPage, error) {
+	direction, comparison := "ASC", ">"
+	if params.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	var staffMembers []*StaffMember
+
+	query := applyStaffMemberFilter(d.db.NewSelect().Model(&staffMembers), params.Filter)
+
+	if params.PageToken != "" {
+		cursor, err := decodePageToken(params)
+		if err != nil {
+			return nil, err
+		}
+
+		var sortKey interface{} = cursor.LastName
+		if params.OrderBy == OrderByCreatedAt {
+			sortKey = cursor.CreatedAt
+		}
+
+		query = query.Where("(?, staff_id) "+comparison+" (?, ?)", bun.Ident(params.OrderBy), sortKey, cursor.StaffID)
+	}
+
+	query = query.
+		OrderExpr("? "+direction+", staff_id "+direction, bun.Ident(params.OrderBy)).
+		Limit(params.PageSize + 1)
+
+	err := d.read(ctx, func(ctx context.Context) error {
+		if err := query.Scan(ctx); err != nil {
+			return fmt.Errorf("failed to list staff members: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newStaffMemberPage(params, staffMembers)
+}
+
+// newStaffMemberPage returns the page of the staff members read for it, which are one more than
+// the page size if there is a next page.
+func newStaffMemberPage(params *ListStaffMembersParams, staffMembers []*StaffMember) (*StaffMemberPage, error) {
+	page := &StaffMemberPage{StaffMembers: staffMembers}
+
+	if len(staffMembers) > params.PageSize {
+		page.StaffMembers = staffMembers[:params.PageSize]
+
+		var err error
+		if page.NextPageToken, err = encodePageToken(params, page.StaffMembers[params.PageSize-1]); err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// StaffMemberSearchResult is a staff member matching a search query and its relevance.
+type StaffMemberSearchResult struct {
+	StaffMember `bun:",extend"`
+
+	Score float64 `bun:"score"`
+}
+
+// SearchStaffMembers returns the staff members best matching the query, most relevant first.
+// A staff member matches if the query is a fuzzy match of one of the words of the
+// search document or a substring of it. Words starting with the query rank highest.
+func (d *Database) SearchStaffMembers(ctx context.Context, query string, limit int) ([]*StaffMemberSearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("%w", ErrSearchQueryEmpty)
+	}
+
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	limit = min(limit, maxSearchLimit)
+
+	// Escape LIKE wildcards so they are matched literally.
+	pattern := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(query)
+
+	var results []*StaffMemberSearchResult
+
+	err := d.read(ctx, func(ctx context.Context) error {
+		if err := d.searchStaffMembers(ctx, query, pattern, limit, &results); err != nil {
+			return fmt.Errorf("failed to search staff members: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// searchStaffMembers runs the query of SearchStaffMembers.
+func (d *Database) searchStaffMembers(ctx context.Context, query, pattern string, limit int,
+	results *[]*StaffMemberSearchResult,
+) error {
+	if d.isSQLite() {
+		// SQLite has no <% operator, but word_similarity is registered by registerSQLiteFunctions.
+		matches := "word_similarity(staff_search_normalize(?), " + staffSearchDocument + ") >= " +
+			strconv.FormatFloat(searchSimilarityThreshold, 'f', -1, 64)
+
+		return selectSearchResults(ctx, d.db, matches, query, pattern, limit, results)
+	}
+
+	return d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// The <% operator matches against pg_trgm.word_similarity_threshold.
+		if _, err := tx.ExecContext(ctx, "SELECT set_config('pg_trgm.word_similarity_threshold', ?, true)",
+			strconv.FormatFloat(searchSimilarityThreshold, 'f', -1, 64)); err != nil {
+			return fmt.Errorf("failed to set similarity threshold: %w", err)
+		}
+
+		return selectSearchResults(ctx, tx, "staff_search_normalize(?) <% "+staffSearchDocument,
+			query, pattern, limit, results)
+	})
+}
+
+// selectSearchResults selects the staff members whose search document the query is a fuzzy match of,
+// by the matches condition, or a substring of, most relevant first.
+// The pattern is the query with its LIKE wildcards escaped.
+func selectSearchResults(ctx context.Context, db bun.IDB, matches, query, pattern string, limit int,
+	results *[]*StaffMemberSearchResult,
+) error {
+	return db.NewSelect().
+		Model(results).
+		ColumnExpr("staff_member.*").
+		ColumnExpr("(word_similarity(staff_search_normalize(?0), "+staffSearchDocument+") + "+
+			"CASE WHEN "+staffSearchDocument+" LIKE staff_search_normalize(?1) || '%' ESCAPE '\\' "+
+			"OR "+staffSearchDocument+" LIKE '% ' || staff_search_normalize(?1) || '%' ESCAPE '\\' "+
+			"THEN 1 ELSE 0 END) / 2 AS score", query, pattern).
+		// Grouped, so that the soft-delete condition bun appends applies to both matches.
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where(matches, query).
+				WhereOr(staffSearchDocument+" LIKE '%' || staff_search_normalize(?) || '%' ESCAPE '\\'", pattern)
+		}).
+		OrderExpr("score DESC, last_name ASC, staff_id ASC").
+		Limit(limit).
+		Scan(ctx)
 }
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/uptrace/bun/driver/pgdriver"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+	"modernc.org/sqlite"
+)
+
+var (
+	ErrStaffMemberAlreadyExists = errors.New("staff member already exists")
+	ErrDatabaseUnavailable      = errors.New("database is unavailable")
+)
+
+// ConflictError reports that a write violated the uniqueness of a StaffMember field.
+type ConflictError struct {
+	// Field is the StaffMember field holding the duplicate value, or "" if it is not known.
+	Field string
+}
+
+// Error implements error.
+func (e *ConflictError) Error() string {
+	if e.Field == "" {
+		return ErrStaffMemberAlreadyExists.Error()
+	}
+
+	return "staff member with this " + e.Field + " already exists"
+}
+
+// Unwrap makes ConflictError match ErrStaffMemberAlreadyExists.
+func (e *ConflictError) Unwrap() error {
+	return ErrStaffMemberAlreadyExists
+}
+
+// uniqueConstraintFields maps the unique constraints of staff_members to the StaffMember fields they cover.
+var uniqueConstraintFields = map[string]string{
+	"staff_members_pkey":             "staffID",
+	"staff_members_staff_id_key":     "staffID",
+	"staff_members_email_key":        "email",
+	"staff_members_phone_number_key": "phoneNumber",
+	"staff_members_subject_key":      "subject",
+}
+
+// invalidArgumentErrors are the Database errors caused by a malformed request.
+var invalidArgumentErrors = []error{
+	ErrStaffMemberNil,
+	ErrStaffMemberIDEmpty,
+	ErrInvalidPageToken,
+	ErrInvalidPageSize,
+	ErrInvalidOrderBy,
+	ErrSearchQueryEmpty,
+	ErrInvalidUpdateMask,
+	ErrInvalidTimeRange,
+	ErrInvalidRevision,
+	ErrBatchTooLarge,
+	ErrInvalidRoster,
+	ErrRosterTooLarge,
+	ErrInvalidImportColumns,
+	ErrStaffIDMismatch,
+	ErrInvalidExportFormat,
+}
+
+// translateDBError classifies an error returned by bun or its drivers as one of the errors of this package.
+// The driver error stays in the chain for logging, but only the errors of this package are shown to clients.
+func translateDBError(err error) error {
+	var (
+		pgErr     pgdriver.Error
+		sqliteErr *sqlite.Error
+	)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, sql.ErrNoRows):
+		return fmt.Errorf("%w", ErrStaffMemberNotFound)
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return err
+	case errors.As(err, &pgErr):
+		return translatePGError(pgErr, err)
+	case errors.As(err, &sqliteErr):
+		return translateSQLiteError(sqliteErr, err)
+	case isConnectionError(err):
+		return fmt.Errorf("%w: %w", ErrDatabaseUnavailable, err)
+	default:
+		return err
+	}
+}
+
+// translatePGError classifies an error reported by the PostgreSQL server by its SQLSTATE code.
+//
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+func translatePGError(pgErr pgdriver.Error, err error) error {
+	code := pgErr.Field('C')
+
+	switch {
+	case code == "23505": // unique_violation
+		return &ConflictError{Field: uniqueConstraintFields[pgErr.Field('n')]}
+	case pgErr.StatementTimeout():
+		return fmt.Errorf("%w: %w", context.DeadlineExceeded, err)
+	case strings.HasPrefix(code, "08"), // connection_exception
+		code == "53300", // too_many_connections
+		code == "57P01", // admin_shutdown
+		code == "57P02", // crash_shutdown
+		code == "57P03": // cannot_connect_now
+		return fmt.Errorf("%w: %w", ErrDatabaseUnavailable, err)
+	default:
+		return err
+	}
+}
+
+// isConnectionError reports whether the error means the connection to the database was lost.
+func isConnectionError(err error) bool {
+	var netErr net.Error
+
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.As(err, &netErr)
+}
+
+// statusError converts an error returned by the Database into a gRPC status error.
+// Errors that are not raised by this package, such as driver errors containing SQL,
+// are logged and replaced by a generic message so they never reach the client.
+func statusError(ctx context.Context, msg string, err error) error {
+	var conflict *ConflictError
+
+	code, description := codes.Internal, "internal error"
+
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(ctx.Err(), context.Canceled):
+		code, description = codes.Canceled, context.Canceled.Error()
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded):
+		code, description = codes.DeadlineExceeded, context.DeadlineExceeded.Error()
+	case errors.As(err, &conflict):
+		return fmt.Errorf("%s: %w", msg, conflictStatus(conflict))
+	case errors.Is(err, ErrStaffMemberNotFound):
+		code, description = codes.NotFound, ErrStaffMemberNotFound.Error()
+	case errors.Is(err, ErrStaleEtag):
+		code, description = codes.Aborted, ErrStaleEtag.Error()
+	case errors.Is(err, ErrUpsertConflict):
+		code, description = codes.Aborted, ErrUpsertConflict.Error()
+	case errors.Is(err, ErrStaffMemberNotDeleted):
+		code, description = codes.FailedPrecondition, ErrStaffMemberNotDeleted.Error()
+	case errors.Is(err, ErrStaffMemberDeleted):
+		code, description = codes.FailedPrecondition, ErrStaffMemberDeleted.Error()
+	case isInvalidArgument(err):
+		code, description = codes.InvalidArgument, err.Error()
+	case errors.Is(err, ErrRevisionExpired):
+		code, description = codes.OutOfRange, ErrRevisionExpired.Error()
+	case errors.Is(err, ErrWatcherTooSlow):
+		code, description = codes.ResourceExhausted, ErrWatcherTooSlow.Error()
+	case errors.Is(err, ErrDatabaseUnavailable):
+		code, description = codes.Unavailable, ErrDatabaseUnavailable.Error()
+	case errors.Is(err, ErrServerShuttingDown):
+		code, description = codes.Unavailable, ErrServerShuttingDown.Error()
+	}
+
+	if code == codes.Internal || code == codes.Unavailable {
+		klog.FromContext(ctx).Error(err, msg)
+	}
+
+	return fmt.Errorf("%s: %w", msg, status.Error(code, description))
+}
+
+// isInvalidArgument reports whether the error was caused by a malformed request.
+func isInvalidArgument(err error) bool {
+	return slices.ContainsFunc(invalidArgumentErrors, func(target error) bool {
+		return errors.Is(err, target)
+	})
+}
+
+// conflictStatus returns an AlreadyExists status naming the conflicting field.
+func conflictStatus(conflict *ConflictError) error {
+	st := status.New(codes.AlreadyExists, conflict.Error())
+
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "STAFF_MEMBER_ALREADY_EXISTS",
+		Domain:   "staff.BetterGR.org",
+		Metadata: map[string]string{"field": conflict.Field},
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
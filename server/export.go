@@ -0,0 +1,261 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var ErrInvalidExportFormat = errors.New("invalid export format")
+
+const (
+	// exportBatchSize is the number of staff members read from the database at a time while exporting.
+	exportBatchSize = 500
+	// exportChunkSize is the size of the chunks the exported file is streamed in.
+	exportChunkSize = 64 << 10
+	// vCardLineLength is the maximal length of a vCard line in octets, after which it is folded.
+	vCardLineLength = 75
+)
+
+// ForEachStaffMember calls fn with every staff member matching the filter, sorted by last name.
+// The staff members are read a page at a time with the keyset cursor of ListStaffMembers,
+// so they are never all held in memory, and fn may take its time without holding a transaction open.
+func (d *Database) ForEachStaffMember(ctx context.Context, filter StaffMemberFilter,
+	fn func(staff *StaffMember) error,
+) error {
+	return forEachStaffMember(ctx, filter, d.listStaffMembers, fn)
+}
+
+// forEachStaffMember calls fn with every staff member matching the filter, reading them a page at a time
+// with the listStaffMembers of a store.
+func forEachStaffMember(ctx context.Context, filter StaffMemberFilter,
+	listStaffMembers func(ctx context.Context, params *ListStaffMembersParams) (*StaffMemberPage, error),
+	fn func(staff *StaffMember) error,
+) error {
+	params := &ListStaffMembersParams{Filter: filter, OrderBy: OrderByLastName, PageSize: exportBatchSize}
+
+	for {
+		page, err := listStaffMembers(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		for _, staff := range page.StaffMembers {
+			if err := fn(staff); err != nil {
+				return err
+			}
+		}
+
+		if page.NextPageToken == "" {
+			return nil
+		}
+
+		params.PageToken = page.NextPageToken
+	}
+}
+
+// staffEncoder writes staff members in an export format.
+type staffEncoder interface {
+	Encode(staff *spb.StaffMember) error
+}
+
+// newStaffEncoder returns an encoder writing staff members to w in the given format.
+// The header of the format, if it has one, is written right away.
+func newStaffEncoder(format spb.ExportFormat, w io.Writer) (staffEncoder, error) {
+	switch format {
+	case spb.ExportFormat_EXPORT_FORMAT_CSV:
+		return newCSVStaffEncoder(w)
+	case spb.ExportFormat_EXPORT_FORMAT_NDJSON:
+		return &ndjsonStaffEncoder{w: w}, nil
+	case spb.ExportFormat_EXPORT_FORMAT_VCARD:
+		return &vCardStaffEncoder{w: w}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExportFormat, format)
+	}
+}
+
+// csvStaffEncoder writes a CSV row per staff member, with a column per field of staffMemberRules,
+// so that the file can be imported back.
+type csvStaffEncoder struct {
+	writer *csv.Writer
+}
+
+// newCSVStaffEncoder returns a csvStaffEncoder after writing the header row.
+// The file starts with a byte order mark so that spreadsheet applications read it as UTF-8.
+func newCSVStaffEncoder(w io.Writer) (*csvStaffEncoder, error) {
+	if _, err := w.Write(utf8BOM); err != nil {
+		return nil, fmt.Errorf("failed to write byte order mark: %w", err)
+	}
+
+	header := make([]string, 0, len(staffMemberRules))
+	for _, rule := range staffMemberRules {
+		header = append(header, rule.path)
+	}
+
+	encoder := &csvStaffEncoder{writer: csv.NewWriter(w)}
+	if err := encoder.write(header); err != nil {
+		return nil, err
+	}
+
+	return encoder, nil
+}
+
+// Encode implements staffEncoder.
+func (e *csvStaffEncoder) Encode(staff *spb.StaffMember) error {
+	record := make([]string, 0, len(staffMemberRules))
+	for _, rule := range staffMemberRules {
+		record = append(record, rule.value(staff))
+	}
+
+	return e.write(record)
+}
+
+// write writes a record through to the underlying writer.
+func (e *csvStaffEncoder) write(record []string) error {
+	if err := e.writer.Write(record); err != nil {
+		return fmt.Errorf("failed to write CSV record: %w", err)
+	}
+
+	e.writer.Flush()
+
+	if err := e.writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV record: %w", err)
+	}
+
+	return nil
+}
+
+// ndjsonStaffEncoder writes a StaffMember JSON object per line.
+type ndjsonStaffEncoder struct {
+	w io.Writer
+}
+
+// Encode implements staffEncoder.
+func (e *ndjsonStaffEncoder) Encode(staff *spb.StaffMember) error {
+	line, err := protojson.Marshal(staff)
+	if err != nil {
+		return fmt.Errorf("failed to encode staff member: %w", err)
+	}
+
+	if _, err := e.w.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write staff member: %w", err)
+	}
+
+	return nil
+}
+
+// vCardStaffEncoder writes a vCard 4.0 per staff member.
+//
+// https://www.rfc-editor.org/rfc/rfc6350
+type vCardStaffEncoder struct {
+	w io.Writer
+}
+
+// vCardEscaper escapes the special characters of vCard text values.
+var vCardEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\r\n", `\n`, "\n", `\n`)
+
+// Encode implements staffEncoder. Properties whose value is empty are left out.
+func (e *vCardStaffEncoder) Encode(staff *spb.StaffMember) error {
+	escape := vCardEscaper.Replace
+
+	lines := []string{"BEGIN:VCARD", "VERSION:4.0"}
+
+	if id, err := uuid.Parse(staff.GetStaffID()); err == nil {
+		lines = append(lines, "UID:"+id.URN())
+	} else {
+		lines = append(lines, "UID;VALUE=text:"+escape(staff.GetStaffID()))
+	}
+
+	lines = append(lines,
+		"FN:"+escape(strings.TrimSpace(staff.GetFirstName()+" "+staff.GetLastName())),
+		"N:"+escape(staff.GetLastName())+";"+escape(staff.GetFirstName())+";;;")
+
+	if staff.GetEmail() != "" {
+		lines = append(lines, "EMAIL;TYPE=work:"+escape(staff.GetEmail()))
+	}
+
+	if staff.GetPhoneNumber() != "" {
+		lines = append(lines, "TEL;VALUE=uri;TYPE=work,voice:tel:"+staff.GetPhoneNumber())
+	}
+
+	if staff.GetTitle() != "" {
+		lines = append(lines, "TITLE:"+escape(staff.GetTitle()))
+	}
+
+	if staff.GetOffice() != "" {
+		// The office is the extended address, such as the room, of the work address.
+		lines = append(lines, "ADR;TYPE=work:;"+escape(staff.GetOffice())+";;;;;")
+	}
+
+	if staff.GetUpdatedAt() != nil {
+		lines = append(lines, "REV:"+staff.GetUpdatedAt().AsTime().UTC().Format("20060102T150405Z"))
+	}
+
+	lines = append(lines, "END:VCARD")
+
+	var card bytes.Buffer
+	for _, line := range lines {
+		card.WriteString(foldVCardLine(line))
+		card.WriteString("\r\n")
+	}
+
+	if _, err := e.w.Write(card.Bytes()); err != nil {
+		return fmt.Errorf("failed to write vCard: %w", err)
+	}
+
+	return nil
+}
+
+// foldVCardLine splits a line longer than vCardLineLength octets into continuation lines starting with a space,
+// without splitting multi-byte characters.
+func foldVCardLine(line string) string {
+	var folded strings.Builder
+
+	length := 0
+
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if length+size > vCardLineLength {
+			folded.WriteString("\r\n ")
+			// The leading space counts towards the length of the continuation line.
+			length = 1
+		}
+
+		folded.WriteRune(r)
+		length += size
+	}
+
+	return folded.String()
+}
+
+// exportStream buffers an exported file and streams it in chunks of exportChunkSize.
+type exportStream struct {
+	stream spb.StaffService_ExportStaffMembersServer
+	buffer bytes.Buffer
+}
+
+// Write implements io.Writer.
+func (s *exportStream) Write(p []byte) (int, error) {
+	return s.buffer.Write(p) //nolint:wrapcheck // bytes.Buffer.Write never fails
+}
+
+// flush sends the full chunks of the buffer, and the rest of it as well if all is set.
+func (s *exportStream) flush(all bool) error {
+	for s.buffer.Len() >= exportChunkSize || (all && s.buffer.Len() > 0) {
+		chunk := bytes.Clone(s.buffer.Next(exportChunkSize))
+		if err := s.stream.Send(&spb.ExportStaffMembersResponse{Chunk: chunk}); err != nil {
+			return fmt.Errorf("failed to send export chunk: %w", err)
+		}
+	}
+
+	return nil
+}
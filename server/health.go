@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// livenessService is the health service reporting whether the server is alive, whatever the state
+	// of the database, so that a liveness probe does not restart servers waiting for the database.
+	livenessService = "liveness"
+	// readinessService is the health service reporting whether the server can serve requests,
+	// which needs the database. The overall health of the server, the service "", is the same.
+	readinessService = "readiness"
+)
+
+// healthCheckInterval is how often the database is pinged to report the readiness of the server.
+var healthCheckInterval = flag.Duration("health-check-interval", 5*time.Second,
+	"how often the database is pinged to report the readiness of the server")
+
+// readinessServices are the health services that are SERVING only while the database can be reached.
+var readinessServices = []string{"", readinessService, spb.StaffService_ServiceDesc.ServiceName}
+
+// HealthChecker serves the grpc.health.v1 Health service, reporting the readiness of the server
+// by periodically pinging its store.
+type HealthChecker struct {
+	server   *health.Server
+	store    StaffStore
+	interval time.Duration
+	// ready is the readiness reported by the last check.
+	ready bool
+}
+
+// NewHealthChecker returns a HealthChecker pinging the store every interval. The server is alive
+// from the start, and ready from the first successful ping.
+func NewHealthChecker(store StaffStore, interval time.Duration) *HealthChecker {
+	server := health.NewServer()
+	server.SetServingStatus(livenessService, healthpb.HealthCheckResponse_SERVING)
+
+	for _, service := range readinessServices {
+		server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	return &HealthChecker{server: server, store: store, interval: interval}
+}
+
+// Register registers the Health service on the gRPC server.
+func (h *HealthChecker) Register(registrar grpc.ServiceRegistrar) {
+	healthpb.RegisterHealthServer(registrar, h.server)
+}
+
+// Run checks the readiness of the server every interval until the context is done.
+func (h *HealthChecker) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		h.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check pings the store, within the check interval, and reports the readiness of the server.
+func (h *HealthChecker) check(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+
+	pingCtx, cancel := context.WithTimeout(ctx, h.interval)
+	defer cancel()
+
+	err := h.store.Ping(pingCtx)
+	if ctx.Err() != nil {
+		return
+	}
+
+	ready := err == nil
+	if ready == h.ready {
+		return
+	}
+
+	h.ready = ready
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if ready {
+		logger.Info("The database is reachable, the server is ready")
+	} else {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+		logger.Error(err, "The database is unreachable, the server is not ready")
+	}
+
+	for _, service := range readinessServices {
+		h.server.SetServingStatus(service, status)
+	}
+}
+
+// Shutdown reports every service as NOT_SERVING, liveness included, for the rest of the life of the server,
+// so that clients and load balancers stop sending requests to a server that is shutting down.
+func (h *HealthChecker) Shutdown() {
+	h.server.Shutdown()
+}
@@ -0,0 +1,494 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	"github.com/uptrace/bun"
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrInvalidRoster        = errors.New("invalid roster")
+	ErrRosterTooLarge       = errors.New("roster is too large")
+	ErrInvalidImportColumns = errors.New("invalid import columns")
+	ErrStaffIDMismatch      = errors.New("staffID does not match the staff member with this email")
+	// errImportDryRun rolls back the transaction of a dry run.
+	errImportDryRun = errors.New("dry run")
+)
+
+const (
+	// maxRosterSize caps the size of an imported roster file.
+	maxRosterSize = 10 << 20
+	// maxRosterUnzipSize caps the unzipped size of an imported XLSX roster.
+	maxRosterUnzipSize = 10 * maxRosterSize
+	// maxImportRows caps the number of rows of an imported roster, besides its header.
+	maxImportRows = 10000
+)
+
+// utf8BOM is the byte order mark spreadsheet applications put at the start of UTF-8 CSV files.
+var utf8BOM = []byte("\ufeff")
+
+// importableStaffMemberFields maps the StaffMember fields a roster may hold to the setters of their value.
+var importableStaffMemberFields = map[string]func(staff *spb.StaffMember, value string){
+	"staffID":     func(staff *spb.StaffMember, value string) { staff.StaffID = value },
+	"firstName":   func(staff *spb.StaffMember, value string) { staff.FirstName = value },
+	"lastName":    func(staff *spb.StaffMember, value string) { staff.LastName = value },
+	"email":       func(staff *spb.StaffMember, value string) { staff.Email = value },
+	"phoneNumber": func(staff *spb.StaffMember, value string) { staff.PhoneNumber = value },
+	"title":       func(staff *spb.StaffMember, value string) { staff.Title = value },
+	"office":      func(staff *spb.StaffMember, value string) { staff.Office = value },
+}
+
+// rosterRow is a non-empty row of a roster.
+type rosterRow struct {
+	// number is the line of the row in a CSV file or its number in an XLSX sheet.
+	number int
+	cells  []string
+}
+
+// parseRoster returns the non-empty rows of a roster file, starting with its header.
+func parseRoster(format spb.ImportFormat, data []byte, sheet string) ([]rosterRow, error) {
+	var (
+		rows []rosterRow
+		err  error
+	)
+
+	switch format {
+	case spb.ImportFormat_IMPORT_FORMAT_CSV:
+		rows, err = parseCSVRoster(data)
+	case spb.ImportFormat_IMPORT_FORMAT_XLSX:
+		rows, err = parseXLSXRoster(data, sheet)
+	default:
+		return nil, fmt.Errorf("%w: unknown format %s", ErrInvalidRoster, format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: the roster has no header", ErrInvalidRoster)
+	}
+
+	if len(rows) > maxImportRows+1 {
+		return nil, fmt.Errorf("%w: %d rows, at most %d are allowed", ErrRosterTooLarge, len(rows)-1, maxImportRows)
+	}
+
+	return rows, nil
+}
+
+// parseCSVRoster returns the non-empty rows of a CSV roster.
+func parseCSVRoster(data []byte) ([]rosterRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, utf8BOM)))
+	// Spreadsheet applications leave out the trailing empty cells of some rows.
+	reader.FieldsPerRecord = -1
+
+	var rows []rosterRow
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRoster, err)
+		}
+
+		if isEmptyRosterRow(record) {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, rosterRow{number: line, cells: record})
+	}
+}
+
+// parseXLSXRoster returns the non-empty rows of a sheet of an XLSX roster, or of its first sheet if sheet is empty.
+func parseXLSXRoster(data []byte, sheet string) ([]rosterRow, error) {
+	file, err := excelize.OpenReader(bytes.NewReader(data), excelize.Options{
+		UnzipSizeLimit:    maxRosterUnzipSize,
+		UnzipXMLSizeLimit: maxRosterUnzipSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRoster, err)
+	}
+
+	defer file.Close()
+
+	if sheet == "" {
+		sheet = file.GetSheetName(0)
+	}
+
+	records, err := file.GetRows(sheet)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRoster, err)
+	}
+
+	var rows []rosterRow
+
+	for index, record := range records {
+		if !isEmptyRosterRow(record) {
+			rows = append(rows, rosterRow{number: index + 1, cells: record})
+		}
+	}
+
+	return rows, nil
+}
+
+// isEmptyRosterRow reports whether every cell of the row is blank.
+func isEmptyRosterRow(cells []string) bool {
+	return !slices.ContainsFunc(cells, func(cell string) bool {
+		return strings.TrimSpace(cell) != ""
+	})
+}
+
+// normalizeHeader folds a header to lowercase letters and digits, so that "E-mail" and "email" match.
+func normalizeHeader(header string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+
+		return -1
+	}, header)
+}
+
+// rosterColumns returns the StaffMember field held by every column of the header, or "" for ignored columns.
+// columns maps headers to the fields their column holds; other headers hold the field of the same name.
+func rosterColumns(header []string, columns map[string]string) ([]string, error) {
+	// mapped maps the normalized headers of columns to their fields.
+	mapped := make(map[string]string, len(columns))
+
+	for _, name := range slices.Sorted(maps.Keys(columns)) {
+		field := columns[name]
+		if _, ok := importableStaffMemberFields[field]; !ok {
+			return nil, fmt.Errorf("%w: column %q is mapped to %q, which is not a StaffMember field",
+				ErrInvalidImportColumns, name, field)
+		}
+
+		if !slices.ContainsFunc(header, func(h string) bool { return normalizeHeader(h) == normalizeHeader(name) }) {
+			return nil, fmt.Errorf("%w: column %q is not in the header", ErrInvalidImportColumns, name)
+		}
+
+		mapped[normalizeHeader(name)] = field
+	}
+
+	fieldsByName := make(map[string]string, len(importableStaffMemberFields))
+	for field := range importableStaffMemberFields {
+		fieldsByName[normalizeHeader(field)] = field
+	}
+
+	fields := make([]string, len(header))
+
+	for index, name := range header {
+		field, ok := mapped[normalizeHeader(name)]
+		if !ok {
+			field = fieldsByName[normalizeHeader(name)]
+		}
+
+		if field == "" {
+			continue
+		}
+
+		if slices.Contains(fields, field) {
+			return nil, fmt.Errorf("%w: several columns hold %s", ErrInvalidImportColumns, field)
+		}
+
+		fields[index] = field
+	}
+
+	return fields, nil
+}
+
+// rosterStaffMember returns the staff member described by a row of the roster.
+func rosterStaffMember(cells, fields []string) *spb.StaffMember {
+	staff := &spb.StaffMember{}
+
+	for index, field := range fields {
+		if field != "" && index < len(cells) {
+			importableStaffMemberFields[field](staff, strings.TrimSpace(cells[index]))
+		}
+	}
+
+	return staff
+}
+
+// ImportMatchBy is the field matching the rows of a roster to existing staff members.
+type ImportMatchBy int
+
+const (
+	ImportMatchByEmail ImportMatchBy = iota
+	ImportMatchByStaffID
+)
+
+// ImportRowStatus is the outcome of importing a single row.
+type ImportRowStatus int
+
+const (
+	ImportRowCreated ImportRowStatus = iota + 1
+	ImportRowUpdated
+	// ImportRowSkipped means the matched staff member already had the values of the row.
+	ImportRowSkipped
+	ImportRowFailed
+)
+
+// ImportRow is a single row of an imported roster.
+type ImportRow struct {
+	// Number identifies the row in the roster file.
+	Number      int
+	StaffMember *spb.StaffMember
+}
+
+// ImportOptions holds the options of ImportStaffMembers.
+type ImportOptions struct {
+	// Fields are the StaffMember fields held by the roster. Matched staff members are updated on these fields only.
+	Fields  []string
+	MatchBy ImportMatchBy
+	// DryRun reports the outcome of every row without writing anything.
+	DryRun bool
+	// Check is called before a row is written, with the staff member it matched, or nil if it creates one.
+	// The rows it fails are reported as failed with its error.
+	Check func(staff *spb.StaffMember, existing *StaffMember) error
+}
+
+// updateMask returns the fields updated on the matched staff members.
+func (o *ImportOptions) updateMask() []string {
+	return slices.DeleteFunc(slices.Clone(o.Fields), func(field string) bool { return field == "staffID" })
+}
+
+// ImportRowResult is the outcome of a single row of an imported roster.
+type ImportRowResult struct {
+	Number  int
+	Status  ImportRowStatus
+	StaffID string
+	// Err is the reason a failed row was not imported.
+	Err error
+}
+
+// ImportStaffMembers creates or updates a staff member for every row of a roster, in a single transaction.
+// Every row runs in its own savepoint, so that a failing row only rolls back its own changes.
+// A row matching an existing staff member by opts.MatchBy updates it, unless it already has the values of the row,
+// and any other row creates a staff member. Rows matching a deleted staff member fail with ErrStaffMemberDeleted
+// and report its staffID, so that it can be restored rather than created again.
+func (d *Database) ImportStaffMembers(ctx context.Context, rows []ImportRow, opts *ImportOptions,
+) ([]ImportRowResult, error) {
+	results := make([]ImportRowResult, len(rows))
+
+	// A roster of maxImportRows takes longer than the query timeout to import.
+	err := d.runInLongTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		for index, row := range rows {
+			result := &results[index]
+			result.Number = row.Number
+
+			err := tx.RunInTx(ctx, nil, func(ctx context.Context, savepoint bun.Tx) error {
+				result.Status, result.StaffID, result.Err = importStaffMember(ctx, savepoint, row.StaffMember, opts)
+
+				return result.Err
+			})
+			if err != nil && result.Err == nil {
+				// The savepoint itself failed, which aborts the transaction.
+				return fmt.Errorf("failed to import row %d: %w", row.Number, translateDBError(err))
+			}
+
+			if result.Err != nil {
+				result.Status = ImportRowFailed
+			}
+
+			if opts.DryRun && result.Status == ImportRowCreated {
+				// The staffID generated for the row is never written.
+				result.StaffID = row.StaffMember.GetStaffID()
+			}
+		}
+
+		if opts.DryRun {
+			return errImportDryRun
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errImportDryRun) {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// importStaffMember creates or updates the staff member of a single row within a transaction.
+func importStaffMember(ctx context.Context, tx bun.Tx, staff *spb.StaffMember, opts *ImportOptions,
+) (ImportRowStatus, string, error) {
+	existing, err := findImportedStaffMember(ctx, tx, staff, opts.MatchBy)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if existing != nil && !existing.DeletedAt.IsZero() {
+		return 0, existing.StaffID, fmt.Errorf("%w", ErrStaffMemberDeleted)
+	}
+
+	if existing == nil {
+		if err := opts.Check(staff, nil); err != nil {
+			return 0, "", err
+		}
+
+		created, err := addStaffMember(ctx, tx, staff)
+		if err != nil {
+			return 0, "", err
+		}
+
+		return ImportRowCreated, created.StaffID, nil
+	}
+
+	if staff.GetStaffID() != "" && staff.GetStaffID() != existing.StaffID {
+		return 0, existing.StaffID, fmt.Errorf("%w", ErrStaffIDMismatch)
+	}
+
+	update := proto.Clone(staff).(*spb.StaffMember) //nolint:forcetypeassert // Clone returns the type it is given
+	update.StaffID = existing.StaffID
+	updateMask := opts.updateMask()
+
+	if err := opts.Check(update, existing); err != nil {
+		return 0, existing.StaffID, err
+	}
+
+	if !changesStaffMember(existing, update, updateMask) {
+		return ImportRowSkipped, existing.StaffID, nil
+	}
+
+	if _, err := updateStaffMember(ctx, tx, update, updateMask, ""); err != nil {
+		return 0, existing.StaffID, err
+	}
+
+	return ImportRowUpdated, existing.StaffID, nil
+}
+
+// findImportedStaffMember returns the staff member a row matches, deleted or not, or nil if it matches none.
+func findImportedStaffMember(ctx context.Context, tx bun.Tx, staff *spb.StaffMember, matchBy ImportMatchBy,
+) (*StaffMember, error) {
+	column, value := "email", staff.GetEmail()
+	if matchBy == ImportMatchByStaffID {
+		column, value = "staff_id", staff.GetStaffID()
+	}
+
+	if value == "" {
+		return nil, nil //nolint:nilnil // rows without the matched field create staff members
+	}
+
+	existing := new(StaffMember)
+
+	err := tx.NewSelect().Model(existing).Where("? = ?", bun.Ident(column), value).WhereAllWithDeleted().Scan(ctx)
+	if err != nil {
+		if err = translateDBError(err); errors.Is(err, ErrStaffMemberNotFound) {
+			return nil, nil //nolint:nilnil // rows matching no staff member create one
+		}
+
+		return nil, fmt.Errorf("failed to find staff member: %w", err)
+	}
+
+	return existing, nil
+}
+
+// receiveRoster returns the roster file streamed by the client, starting with the chunk of the first request.
+func receiveRoster(stream spb.StaffService_ImportStaffMembersServer, first *spb.ImportStaffMembersRequest,
+) ([]byte, error) {
+	var roster bytes.Buffer
+
+	for req := first; ; {
+		if roster.Len()+len(req.GetChunk()) > maxRosterSize {
+			return nil, fmt.Errorf("%w: at most %d bytes are allowed", ErrRosterTooLarge, maxRosterSize)
+		}
+
+		roster.Write(req.GetChunk())
+
+		var err error
+		if req, err = stream.Recv(); errors.Is(err, io.EOF) {
+			return roster.Bytes(), nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to receive roster: %w", err)
+		}
+	}
+}
+
+// importRows returns the rows of a roster following its header, and the StaffMember fields the roster holds.
+// The roster must hold the field its rows are matched by.
+func importRows(rows []rosterRow, columns map[string]string, matchBy ImportMatchBy) ([]ImportRow, []string, error) {
+	fields, err := rosterColumns(rows[0].cells, columns)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	matchField := "email"
+	if matchBy == ImportMatchByStaffID {
+		matchField = "staffID"
+	}
+
+	if !slices.Contains(fields, matchField) {
+		return nil, nil, fmt.Errorf("%w: no column holds the %s the rows are matched by", ErrInvalidImportColumns, matchField)
+	}
+
+	imported := make([]ImportRow, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		imported = append(imported, ImportRow{Number: row.number, StaffMember: rosterStaffMember(row.cells, fields)})
+	}
+
+	return imported, slices.DeleteFunc(fields, func(field string) bool { return field == "" }), nil
+}
+
+// importRowStatuses maps the outcomes of the rows to their protobuf representation.
+var importRowStatuses = map[ImportRowStatus]spb.ImportRowStatus{
+	ImportRowCreated: spb.ImportRowStatus_IMPORT_ROW_CREATED,
+	ImportRowUpdated: spb.ImportRowStatus_IMPORT_ROW_UPDATED,
+	ImportRowSkipped: spb.ImportRowStatus_IMPORT_ROW_SKIPPED,
+	ImportRowFailed:  spb.ImportRowStatus_IMPORT_ROW_FAILED,
+}
+
+// importReport returns the report of an import, counting the rows of each outcome.
+func importReport(ctx context.Context, results []ImportRowResult, dryRun bool) *spb.ImportStaffMembersResponse {
+	report := &spb.ImportStaffMembersResponse{DryRun: dryRun}
+
+	for _, result := range results {
+		row := &spb.ImportRowResult{
+			Row:     int32(result.Number), //nolint:gosec // rosters have at most maxImportRows rows
+			Status:  importRowStatuses[result.Status],
+			StaffID: result.StaffID,
+		}
+
+		switch result.Status {
+		case ImportRowCreated:
+			report.Created++
+		case ImportRowUpdated:
+			report.Updated++
+		case ImportRowSkipped:
+			report.Skipped++
+		case ImportRowFailed:
+			report.Failed++
+			row.Message = importRowMessage(ctx, result.Err)
+		}
+
+		report.Rows = append(report.Rows, row)
+	}
+
+	return report
+}
+
+// importRowMessage describes why a row failed, by the message of the gRPC status of its error.
+func importRowMessage(ctx context.Context, err error) string {
+	var withStatus interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &withStatus) {
+		_ = errors.As(statusError(ctx, "failed to import row", err), &withStatus)
+	}
+
+	return withStatus.GRPCStatus().Message()
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// metricsNamespace prefixes the names of the metrics of the service.
+	metricsNamespace = "staff"
+	// metricsDBName is the db_name label of the database metrics, as the Go database/sql metrics name it.
+	metricsDBName = "staff"
+	// metricsReadHeaderTimeout bounds the time scrapers take to send their request headers.
+	metricsReadHeaderTimeout = 10 * time.Second
+)
+
+// metricsAddress is the address of the HTTP server of the /metrics endpoint.
+var metricsAddress = flag.String("metrics-address", ":9090",
+	"address the Prometheus /metrics endpoint is served on; empty to disable it")
+
+// Metrics holds the Prometheus metrics of a StaffServer:
+//
+//   - staff_grpc_server_started_total, staff_grpc_server_handled_total and staff_grpc_server_handling_seconds
+//     count the RPCs by grpc_service, grpc_method and grpc_type, and grpc_code once they complete;
+//   - staff_db_query_duration_seconds times the SQL queries by db_name, operation and status;
+//   - go_sql_* report the connection pool statistics of the database by db_name.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcsStarted   *prometheus.CounterVec
+	rpcsHandled   *prometheus.CounterVec
+	rpcDurations  *prometheus.HistogramVec
+	queryDuration *prometheus.HistogramVec
+}
+
+// NewMetrics returns the metrics of a StaffServer, registered with a registry of their own
+// along with the Go runtime and process metrics.
+func NewMetrics() *Metrics {
+	labels := []string{"grpc_service", "grpc_method", "grpc_type"}
+	metrics := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcsStarted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "grpc_server_started_total",
+			Help:      "Number of RPCs started on the server.",
+		}, labels),
+		rpcsHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "grpc_server_handled_total",
+			Help:      "Number of RPCs completed on the server, by status code.",
+		}, append(labels, "grpc_code")),
+		rpcDurations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "grpc_server_handling_seconds",
+			Help:      "Duration of the RPCs completed on the server.",
+			Buckets:   prometheus.DefBuckets,
+		}, labels),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "Duration of the SQL queries, by operation and whether they failed.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"db_name", "operation", "status"}),
+	}
+
+	metrics.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		metrics.rpcsStarted,
+		metrics.rpcsHandled,
+		metrics.rpcDurations,
+		metrics.queryDuration,
+	)
+
+	return metrics
+}
+
+// Handler returns the HTTP handler of the /metrics endpoint.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// InstrumentStore records the query durations and the connection pool statistics of a Database.
+// Stores other than a Database are not instrumented. It must be called before the store is used.
+func (m *Metrics) InstrumentStore(store StaffStore) error {
+	database, ok := store.(*Database)
+	if !ok {
+		return nil
+	}
+
+	if err := m.registry.Register(collectors.NewDBStatsCollector(database.db.DB, metricsDBName)); err != nil {
+		return fmt.Errorf("failed to register database metrics: %w", err)
+	}
+
+	database.db.AddQueryHook(&metricsQueryHook{metrics: m})
+
+	return nil
+}
+
+// rpcLabels returns the grpc_service and grpc_method labels of the full method name of an RPC,
+// as in "/staff.StaffService/GetStaffMember".
+func rpcLabels(fullMethod string) (string, string) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+
+	return service, method
+}
+
+// observeRPC records the start of an RPC and returns the function recording its completion with err.
+func (m *Metrics) observeRPC(fullMethod, rpcType string) func(err error) {
+	service, method := rpcLabels(fullMethod)
+	start := time.Now()
+
+	m.rpcsStarted.WithLabelValues(service, method, rpcType).Inc()
+
+	return func(err error) {
+		m.rpcsHandled.WithLabelValues(service, method, rpcType, status.Code(err).String()).Inc()
+		m.rpcDurations.WithLabelValues(service, method, rpcType).Observe(time.Since(start).Seconds())
+	}
+}
+
+// UnaryServerInterceptor returns the interceptor recording the metrics of unary RPCs.
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		done := m.observeRPC(info.FullMethod, "unary")
+		resp, err := handler(ctx, req)
+		done(err)
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns the interceptor recording the metrics of streaming RPCs.
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		rpcType := "bidi_stream"
+
+		switch {
+		case info.IsClientStream && !info.IsServerStream:
+			rpcType = "client_stream"
+		case !info.IsClientStream && info.IsServerStream:
+			rpcType = "server_stream"
+		}
+
+		done := m.observeRPC(info.FullMethod, rpcType)
+		err := handler(srv, stream)
+		done(err)
+
+		return err
+	}
+}
+
+// metricsQueryHook is the bun query hook timing the SQL queries.
+type metricsQueryHook struct {
+	metrics *Metrics
+}
+
+var _ bun.QueryHook = (*metricsQueryHook)(nil)
+
+// BeforeQuery implements bun.QueryHook.
+func (h *metricsQueryHook) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
+	return ctx
+}
+
+// AfterQuery implements bun.QueryHook. Queries finding no rows did not fail.
+func (h *metricsQueryHook) AfterQuery(_ context.Context, event *bun.QueryEvent) {
+	queryStatus := "ok"
+	if event.Err != nil && !errors.Is(event.Err, sql.ErrNoRows) {
+		queryStatus = "error"
+	}
+
+	h.metrics.queryDuration.WithLabelValues(metricsDBName, event.Operation(), queryStatus).
+		Observe(time.Since(event.StartTime).Seconds())
+}
+
+// serveMetrics serves the /metrics endpoint on the listener until the context is canceled.
+func (m *Metrics) serveMetrics(ctx context.Context, lis net.Listener) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.Handler())
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: metricsReadHeaderTimeout}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	if err := server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		klog.FromContext(ctx).Error(err, "Failed to serve metrics")
+	}
+}
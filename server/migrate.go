@@ -0,0 +1,298 @@
+package main
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"text/tabwriter"
+	"time"
+
+	"github.com/uptrace/bun/dialect"
+	"github.com/uptrace/bun/migrate"
+	"k8s.io/klog/v2"
+)
+
+var ErrSchemaOutOfDate = errors.New("database schema is out of date")
+
+// migrationLockID is the PostgreSQL advisory lock held while migrating, so that replicas starting
+// together apply each migration once.
+const migrationLockID = 0x5354_4146_46
+
+// autoMigrate makes the server apply the pending migrations on startup.
+var autoMigrate = flag.Bool("auto-migrate", true,
+	"apply pending schema migrations on startup; otherwise startup fails until they are applied with `migrate up`")
+
+// migrationFiles holds the SQL migrations of each dialect, named <version>_<name>.[tx.]up.sql and
+// <version>_<name>.[tx.]down.sql. Statements of a migration are separated by --bun:split lines,
+// and tx migrations run in a transaction.
+//
+//go:embed migrations/*/*.sql
+var migrationFiles embed.FS
+
+// migrationDirs maps the dialects to the directories of their migrations, which create the same schema.
+var migrationDirs = map[dialect.Name]string{
+	dialect.PG:     "migrations/postgres",
+	dialect.SQLite: "migrations/sqlite",
+}
+
+// schemaMigrations returns the embedded migrations of the dialect.
+func schemaMigrations(name dialect.Name) (*migrate.Migrations, error) {
+	dir, ok := migrationDirs[name]
+	if !ok {
+		return nil, fmt.Errorf("no migrations for the %s dialect", name)
+	}
+
+	files, err := fs.Sub(migrationFiles, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	migrations := migrate.NewMigrations()
+	if err := migrations.Discover(files); err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	return migrations, nil
+}
+
+// newMigrator returns the migrator of the embedded migrations, which records the applied migrations
+// in the schema_migrations table.
+func (d *Database) newMigrator() (*migrate.Migrator, error) {
+	migrations, err := schemaMigrations(d.db.Dialect().Name())
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewMigrator(d.db, migrations,
+		migrate.WithTableName("schema_migrations"),
+		migrate.WithLocksTableName("schema_migration_locks"),
+		migrate.WithMarkAppliedOnSuccess(true)), nil
+}
+
+// withMigrator calls fn with an initialized migrator while holding the migration lock.
+// SQLite has no advisory locks, and its transactions already exclude each other.
+func (d *Database) withMigrator(ctx context.Context, fn func(migrator *migrate.Migrator) error) error {
+	migrator, err := d.newMigrator()
+	if err != nil {
+		return err
+	}
+
+	if d.isSQLite() {
+		if err := migrator.Init(ctx); err != nil {
+			return fmt.Errorf("failed to create migrations table: %w", translateDBError(err))
+		}
+
+		return fn(migrator)
+	}
+
+	// The advisory lock belongs to the session, so it is taken and released on the same connection.
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to lock migrations: %w", translateDBError(err))
+	}
+
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock(?)", migrationLockID); err != nil {
+		return fmt.Errorf("failed to lock migrations: %w", translateDBError(err))
+	}
+
+	defer func() {
+		_, _ = conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock(?)", migrationLockID)
+	}()
+
+	if err := migrator.Init(ctx); err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", translateDBError(err))
+	}
+
+	return fn(migrator)
+}
+
+// Migrate applies the pending migrations in order, as a single group, and returns it.
+// A failed migration stops the group, and the migrations applied before it stay applied.
+func (d *Database) Migrate(ctx context.Context) (*migrate.MigrationGroup, error) {
+	var group *migrate.MigrationGroup
+
+	err := d.withMigrator(ctx, func(migrator *migrate.Migrator) error {
+		var err error
+		if group, err = migrator.Migrate(ctx); err != nil {
+			return fmt.Errorf("failed to apply migrations: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// Rollback reverts the migrations of the last group, newest first, and returns it.
+func (d *Database) Rollback(ctx context.Context) (*migrate.MigrationGroup, error) {
+	var group *migrate.MigrationGroup
+
+	err := d.withMigrator(ctx, func(migrator *migrate.Migrator) error {
+		var err error
+		if group, err = migrator.Rollback(ctx); err != nil {
+			return fmt.Errorf("failed to revert migrations: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// MigrationStatus returns every migration, oldest first, with the group and time of the applied ones.
+func (d *Database) MigrationStatus(ctx context.Context) (migrate.MigrationSlice, error) {
+	var migrations migrate.MigrationSlice
+
+	err := d.withMigrator(ctx, func(migrator *migrate.Migrator) error {
+		var err error
+		if migrations, err = migrator.MigrationsWithStatus(ctx); err != nil {
+			return fmt.Errorf("failed to read applied migrations: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return migrations, nil
+}
+
+// migrateSchema applies the pending migrations if autoMigrate is set,
+// and otherwise checks that there are none.
+func (d *Database) migrateSchema(ctx context.Context) error {
+	if *autoMigrate {
+		group, err := d.Migrate(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !group.IsZero() {
+			klog.Infof("Applied migration %s.", group)
+		}
+
+		return nil
+	}
+
+	migrations, err := d.MigrationStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	if pending := migrations.Unapplied(); len(pending) > 0 {
+		return fmt.Errorf("%w: %d migrations are pending, starting with %s", ErrSchemaOutOfDate,
+			len(pending), pending[0])
+	}
+
+	return nil
+}
+
+// migrateCommands are the subcommands of the migrate command.
+var migrateCommands = map[string]func(ctx context.Context, database *Database, out io.Writer) error{
+	"up": func(ctx context.Context, database *Database, out io.Writer) error {
+		group, err := database.Migrate(ctx)
+		if err != nil {
+			return err
+		}
+
+		printMigrationGroup(out, "Applied", group)
+
+		return nil
+	},
+	"down": func(ctx context.Context, database *Database, out io.Writer) error {
+		group, err := database.Rollback(ctx)
+		if err != nil {
+			return err
+		}
+
+		printMigrationGroup(out, "Reverted", group)
+
+		return nil
+	},
+	"status": func(ctx context.Context, database *Database, out io.Writer) error {
+		migrations, err := database.MigrationStatus(ctx)
+		if err != nil {
+			return err
+		}
+
+		printMigrationStatus(out, migrations)
+
+		return nil
+	},
+}
+
+// runMigrateCommand runs `migrate up|down|status`, which apply the pending migrations,
+// revert the last group of migrations and list the migrations respectively.
+func runMigrateCommand(ctx context.Context, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	flags.SetOutput(out)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: migrate up|down|status")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", ErrUsage, err)
+	}
+
+	run, ok := migrateCommands[flags.Arg(0)]
+	if flags.NArg() != 1 || !ok {
+		flags.Usage()
+
+		return fmt.Errorf("%w: expected one of up, down or status", ErrUsage)
+	}
+
+	database, err := OpenDatabase(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer database.db.Close()
+
+	return run(ctx, database, out)
+}
+
+// printMigrationGroup prints the migrations of a group that was applied or reverted.
+func printMigrationGroup(out io.Writer, action string, group *migrate.MigrationGroup) {
+	if group.IsZero() {
+		fmt.Fprintln(out, "No migrations to run.")
+
+		return
+	}
+
+	fmt.Fprintf(out, "%s group %d:\n", action, group.ID)
+
+	for _, migration := range group.Migrations {
+		fmt.Fprintf(out, "  %s\n", migration)
+	}
+}
+
+// printMigrationStatus prints a line per migration, saying when it was applied.
+func printMigrationStatus(out io.Writer, migrations migrate.MigrationSlice) {
+	table := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "MIGRATION\tGROUP\tAPPLIED")
+
+	for _, migration := range migrations {
+		if !migration.IsApplied() {
+			fmt.Fprintf(table, "%s\t-\tpending\n", migration)
+
+			continue
+		}
+
+		fmt.Fprintf(table, "%s\t%d\t%s\n", migration, migration.GroupID, migration.MigratedAt.Format(time.RFC3339))
+	}
+
+	_ = table.Flush()
+}
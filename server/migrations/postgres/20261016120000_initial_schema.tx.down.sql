@@ -0,0 +1,19 @@
+-- The pg_trgm and unaccent extensions are left installed, as other schemas may use them.
+
+DROP TABLE IF EXISTS staff_outbox;
+
+--bun:split
+
+DROP TABLE IF EXISTS staff_audit_log;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS staff_audit_log_immutable();
+
+--bun:split
+
+DROP TABLE IF EXISTS staff_members;
+
+--bun:split
+
+DROP FUNCTION IF EXISTS staff_search_normalize(text);
@@ -0,0 +1,125 @@
+-- The schema the server created before it had migrations. Every statement is idempotent,
+-- so that databases created by those versions are brought up to date as well.
+
+CREATE TABLE IF NOT EXISTS staff_members (
+	staff_id varchar NOT NULL,
+	first_name varchar NOT NULL,
+	last_name varchar NOT NULL,
+	email varchar NOT NULL,
+	phone_number varchar NOT NULL,
+	title varchar,
+	office varchar,
+	created_at timestamptz DEFAULT current_timestamp,
+	updated_at timestamptz DEFAULT current_timestamp,
+	PRIMARY KEY (staff_id),
+	UNIQUE (staff_id),
+	UNIQUE (email),
+	UNIQUE (phone_number)
+);
+
+--bun:split
+
+ALTER TABLE staff_members ADD COLUMN IF NOT EXISTS created_by varchar;
+
+--bun:split
+
+ALTER TABLE staff_members ADD COLUMN IF NOT EXISTS updated_by varchar;
+
+--bun:split
+
+ALTER TABLE staff_members ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+
+--bun:split
+
+ALTER TABLE staff_members ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+
+--bun:split
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+--bun:split
+
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+--bun:split
+
+-- staff_search_normalize lowercases, strips accents and Hebrew niqqud and replaces Hebrew final letters
+-- with their regular form, so "Cohén", "cohen" and "כֹּהֵן" compare equal to their plain forms.
+CREATE OR REPLACE FUNCTION staff_search_normalize(value text) RETURNS text AS $$
+	SELECT translate(
+		lower(public.unaccent('public.unaccent'::regdictionary,
+			regexp_replace(coalesce(value, ''), '[\u0591-\u05C7]', '', 'g'))),
+		'ךםןףץ', 'כמנפצ')
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+--bun:split
+
+-- The expression must match staffSearchDocument.
+CREATE INDEX IF NOT EXISTS staff_members_search_idx ON staff_members USING gin (
+	staff_search_normalize(first_name || ' ' || last_name || ' ' || email || ' ' || coalesce(office, ''))
+	gin_trgm_ops
+);
+
+--bun:split
+
+CREATE TABLE IF NOT EXISTS staff_audit_log (
+	event_id bigserial NOT NULL,
+	staff_id varchar NOT NULL,
+	action varchar NOT NULL,
+	rpc varchar NOT NULL,
+	actor varchar NOT NULL,
+	request_id varchar NOT NULL,
+	before jsonb,
+	after jsonb,
+	created_at timestamptz NOT NULL,
+	PRIMARY KEY (event_id)
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS staff_audit_log_staff_id_idx ON staff_audit_log (staff_id, event_id);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS staff_audit_log_actor_idx ON staff_audit_log (actor, event_id);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS staff_audit_log_created_at_idx ON staff_audit_log (created_at);
+
+--bun:split
+
+-- The audit log is append-only.
+CREATE OR REPLACE FUNCTION staff_audit_log_immutable() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'staff_audit_log is append-only';
+END
+$$ LANGUAGE plpgsql;
+
+--bun:split
+
+DROP TRIGGER IF EXISTS staff_audit_log_immutable ON staff_audit_log;
+
+--bun:split
+
+CREATE TRIGGER staff_audit_log_immutable BEFORE UPDATE OR DELETE ON staff_audit_log
+	FOR EACH ROW EXECUTE FUNCTION staff_audit_log_immutable();
+
+--bun:split
+
+CREATE TABLE IF NOT EXISTS staff_outbox (
+	event_id bigserial NOT NULL,
+	subject varchar NOT NULL,
+	staff_id varchar NOT NULL,
+	payload bytea NOT NULL,
+	created_at timestamptz NOT NULL,
+	published_at timestamptz,
+	attempts bigint NOT NULL,
+	last_error varchar,
+	PRIMARY KEY (event_id)
+);
+
+--bun:split
+
+-- The relay finds the unpublished events by this index.
+CREATE INDEX IF NOT EXISTS staff_outbox_unpublished_idx ON staff_outbox (event_id) WHERE published_at IS NULL;
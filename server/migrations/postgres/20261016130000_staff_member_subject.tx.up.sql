@@ -0,0 +1,8 @@
+-- The subject links a staff member to the account their tokens are issued to,
+-- so that they can update their own record.
+
+ALTER TABLE staff_members ADD COLUMN IF NOT EXISTS subject varchar;
+
+--bun:split
+
+CREATE UNIQUE INDEX IF NOT EXISTS staff_members_subject_key ON staff_members (subject);
@@ -0,0 +1,7 @@
+-- A relay claims the events it publishes, so that it publishes them without holding their row locks.
+
+ALTER TABLE staff_outbox ADD COLUMN IF NOT EXISTS claimed_by varchar;
+
+--bun:split
+
+ALTER TABLE staff_outbox ADD COLUMN IF NOT EXISTS claimed_until timestamptz;
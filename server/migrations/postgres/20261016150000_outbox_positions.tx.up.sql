@@ -0,0 +1,12 @@
+-- Watchers stream the events in the order of the transactions that wrote them, as event IDs are
+-- allocated before their transactions commit. The events written before are ordered first.
+
+ALTER TABLE staff_outbox ADD COLUMN IF NOT EXISTS xact_id bigint NOT NULL DEFAULT 0;
+
+--bun:split
+
+ALTER TABLE staff_outbox ALTER COLUMN xact_id SET DEFAULT pg_current_xact_id()::text::bigint;
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS staff_outbox_position_idx ON staff_outbox (xact_id, event_id);
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS staff_outbox;
+
+--bun:split
+
+DROP TABLE IF EXISTS staff_audit_log;
+
+--bun:split
+
+DROP TABLE IF EXISTS staff_members;
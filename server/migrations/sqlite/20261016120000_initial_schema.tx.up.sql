@@ -0,0 +1,80 @@
+-- The SQLite version of the PostgreSQL initial schema, with the same tables, columns and constraints.
+-- Staff members are searched without an index, as SQLite has no trigram matching.
+
+CREATE TABLE IF NOT EXISTS staff_members (
+	staff_id varchar NOT NULL,
+	first_name varchar NOT NULL,
+	last_name varchar NOT NULL,
+	email varchar NOT NULL,
+	phone_number varchar NOT NULL,
+	title varchar,
+	office varchar,
+	created_at timestamp DEFAULT current_timestamp,
+	updated_at timestamp DEFAULT current_timestamp,
+	created_by varchar,
+	updated_by varchar,
+	deleted_at timestamp,
+	version bigint NOT NULL DEFAULT 1,
+	CONSTRAINT staff_members_pkey PRIMARY KEY (staff_id),
+	CONSTRAINT staff_members_email_key UNIQUE (email),
+	CONSTRAINT staff_members_phone_number_key UNIQUE (phone_number)
+);
+
+--bun:split
+
+CREATE TABLE IF NOT EXISTS staff_audit_log (
+	event_id integer PRIMARY KEY AUTOINCREMENT,
+	staff_id varchar NOT NULL,
+	action varchar NOT NULL,
+	rpc varchar NOT NULL,
+	actor varchar NOT NULL,
+	request_id varchar NOT NULL,
+	before text,
+	after text,
+	created_at timestamp NOT NULL
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS staff_audit_log_staff_id_idx ON staff_audit_log (staff_id, event_id);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS staff_audit_log_actor_idx ON staff_audit_log (actor, event_id);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS staff_audit_log_created_at_idx ON staff_audit_log (created_at);
+
+--bun:split
+
+-- The audit log is append-only.
+CREATE TRIGGER IF NOT EXISTS staff_audit_log_immutable_update BEFORE UPDATE ON staff_audit_log
+BEGIN
+	SELECT RAISE(ABORT, 'staff_audit_log is append-only');
+END;
+
+--bun:split
+
+CREATE TRIGGER IF NOT EXISTS staff_audit_log_immutable_delete BEFORE DELETE ON staff_audit_log
+BEGIN
+	SELECT RAISE(ABORT, 'staff_audit_log is append-only');
+END;
+
+--bun:split
+
+CREATE TABLE IF NOT EXISTS staff_outbox (
+	event_id integer PRIMARY KEY AUTOINCREMENT,
+	subject varchar NOT NULL,
+	staff_id varchar NOT NULL,
+	payload blob NOT NULL,
+	created_at timestamp NOT NULL,
+	published_at timestamp,
+	attempts bigint NOT NULL,
+	last_error varchar
+);
+
+--bun:split
+
+-- The relay finds the unpublished events by this index.
+CREATE INDEX IF NOT EXISTS staff_outbox_unpublished_idx ON staff_outbox (event_id) WHERE published_at IS NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS staff_members_subject_key;
+
+--bun:split
+
+ALTER TABLE staff_members DROP COLUMN subject;
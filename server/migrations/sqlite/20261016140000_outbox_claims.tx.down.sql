@@ -0,0 +1,5 @@
+ALTER TABLE staff_outbox DROP COLUMN claimed_until;
+
+--bun:split
+
+ALTER TABLE staff_outbox DROP COLUMN claimed_by;
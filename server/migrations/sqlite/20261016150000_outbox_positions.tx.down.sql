@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS staff_outbox_position_idx;
+
+--bun:split
+
+ALTER TABLE staff_outbox DROP COLUMN xact_id;
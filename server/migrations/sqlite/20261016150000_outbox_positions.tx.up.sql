@@ -0,0 +1,8 @@
+-- SQLite serializes its transactions, so watchers stream the events in the order of their IDs,
+-- and the transaction IDs PostgreSQL orders them by first are all 0.
+
+ALTER TABLE staff_outbox ADD COLUMN xact_id bigint NOT NULL DEFAULT 0;
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS staff_outbox_position_idx ON staff_outbox (xact_id, event_id);
@@ -0,0 +1,414 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// outboxBatchSize is the maximal number of events relayed in a single transaction.
+	outboxBatchSize = 100
+	// outboxPollInterval is how often the relay looks for new events when it is idle.
+	outboxPollInterval = time.Second
+	// outboxInitialBackoff and outboxMaxBackoff bound the wait before retrying a failed publish.
+	outboxInitialBackoff = time.Second
+	outboxMaxBackoff     = time.Minute
+	// outboxClaimTimeout bounds the time a relay takes to publish the events it claimed,
+	// after which the relays of the other replicas may publish them.
+	outboxClaimTimeout = time.Minute
+	// outboxRetention is how long events are kept before they are pruned.
+	outboxRetention = 7 * 24 * time.Hour
+	// outboxPruneInterval is how often the OutboxPruner prunes events.
+	outboxPruneInterval = time.Hour
+)
+
+// dropUnpublishedEvents makes the server remove the staff events that were not published within
+// the outbox retention, for deployments that only stream them to watchers.
+var dropUnpublishedEvents = flag.Bool("drop-unpublished-events", false,
+	"remove the staff events that were not published within the outbox retention of 7 days, "+
+		"which are then never delivered; the outbox is shared by every replica")
+
+// outboxRetryPolicy is the backoff of retrying failed publishes, which are retried until they succeed.
+var outboxRetryPolicy = retryPolicy{initialBackoff: outboxInitialBackoff, maxBackoff: outboxMaxBackoff}
+
+// staffEventSubjects maps the event types to the subjects they are published on.
+var staffEventSubjects = map[spb.StaffEventType]string{
+	spb.StaffEventType_STAFF_CREATED: "staff.created",
+	spb.StaffEventType_STAFF_UPDATED: "staff.updated",
+	spb.StaffEventType_STAFF_DELETED: "staff.deleted",
+}
+
+// staffEventTypes maps the changes announced to other services to their event types.
+// Restoring a staff member announces it as updated; purging is not announced, as it was deleted before.
+var staffEventTypes = map[auditAction]spb.StaffEventType{
+	auditActionCreate:  spb.StaffEventType_STAFF_CREATED,
+	auditActionUpdate:  spb.StaffEventType_STAFF_UPDATED,
+	auditActionRestore: spb.StaffEventType_STAFF_UPDATED,
+	auditActionDelete:  spb.StaffEventType_STAFF_DELETED,
+}
+
+// StaffOutboxEvent represents the staff_outbox table.
+// Events are inserted in the transaction of the change they announce, so that an event
+// exists if and only if its change was committed, and are published afterwards by the OutboxRelay.
+type StaffOutboxEvent struct {
+	bun.BaseModel `bun:"table:staff_outbox"`
+
+	EventID     int64     `bun:"event_id,pk,autoincrement"`
+	Subject     string    `bun:"subject,notnull"`
+	StaffID     string    `bun:"staff_id,notnull"`
+	Payload     []byte    `bun:"payload,notnull"`
+	CreatedAt   time.Time `bun:"created_at,notnull"`
+	PublishedAt time.Time `bun:"published_at,nullzero"`
+	Attempts    int       `bun:"attempts,notnull"`
+	LastError   string    `bun:"last_error"`
+	// ClaimedBy and ClaimedUntil are the claim of the relay publishing the event, if any.
+	ClaimedBy    string    `bun:"claimed_by,nullzero"`
+	ClaimedUntil time.Time `bun:"claimed_until,nullzero"`
+	// XactID is the ID of the transaction that wrote the event, the default of the column on PostgreSQL,
+	// or 0 on SQLite.
+	XactID int64 `bun:"xact_id,nullzero,notnull,default:0"`
+}
+
+// position returns the position of the event in the order it is streamed to watchers.
+func (e *StaffOutboxEvent) position() staffEventPosition {
+	return staffEventPosition{XactID: e.XactID, EventID: e.EventID}
+}
+
+// message returns the message publishing the event.
+func (e *StaffOutboxEvent) message() *Message {
+	return &Message{
+		ID:      strconv.FormatInt(e.EventID, 10),
+		Subject: e.Subject,
+		Data:    e.Payload,
+	}
+}
+
+// enqueueStaffEvent inserts the event announcing a change within the transaction making the change.
+// Changes that are not announced are ignored.
+func enqueueStaffEvent(ctx context.Context, tx bun.IDB, action auditAction, staff *StaffMember) error {
+	event, err := newStaffOutboxEvent(action, staff)
+	if err != nil || event == nil {
+		return err
+	}
+
+	if _, err := tx.NewInsert().Model(event).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to enqueue staff event: %w", translateDBError(err))
+	}
+
+	return notifyStaffEvent(ctx, tx)
+}
+
+// newStaffOutboxEvent returns the event announcing a change, without its EventID,
+// or nil if the change is not announced.
+func newStaffOutboxEvent(action auditAction, staff *StaffMember) (*StaffOutboxEvent, error) {
+	eventType, ok := staffEventTypes[action]
+	if !ok {
+		return nil, nil //nolint:nilnil // unannounced changes have no event
+	}
+
+	now := time.Now()
+
+	payload, err := proto.Marshal(&spb.StaffEvent{
+		Type:        eventType,
+		StaffMember: staffMemberToProto(staff),
+		OccurredAt:  timestamppb.New(now),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode staff event: %w", err)
+	}
+
+	return &StaffOutboxEvent{
+		Subject:   staffEventSubjects[eventType],
+		StaffID:   staff.StaffID,
+		Payload:   payload,
+		CreatedAt: now,
+	}, nil
+}
+
+// RelayOutboxEvents publishes up to limit unpublished events in order, marking them as published.
+// Publishing stops at the first failure, which is recorded on the event, so that
+// later events are not published before it. It returns the number of events published.
+//
+// The events are claimed for outboxClaimTimeout in a first transaction, published without holding
+// any lock, and marked as published in a second one. While they are claimed, the relays of the other
+// replicas publish nothing, so that the events stay in order; if the relay stops before marking them,
+// they are published again once the claim expires.
+func (d *Database) RelayOutboxEvents(ctx context.Context, limit int,
+	publish func(ctx context.Context, event *StaffOutboxEvent) error,
+) (int, error) {
+	claimID := uuid.NewString()
+
+	events, err := d.claimOutboxEvents(ctx, claimID, limit)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, outboxClaimTimeout)
+	defer cancel()
+
+	published := 0
+
+	var publishErr error
+
+	for _, event := range events {
+		if publishErr = publish(publishCtx, event); publishErr != nil {
+			break
+		}
+
+		published++
+	}
+
+	// The published events are marked even if the relay is stopping, so that they are not published again.
+	if err := d.completeOutboxEvents(context.WithoutCancel(ctx), claimID, events, published, publishErr); err != nil {
+		return published, err
+	}
+
+	if publishErr != nil {
+		return published, fmt.Errorf("failed to publish event: %w", publishErr)
+	}
+
+	return published, nil
+}
+
+// claimOutboxEvents claims up to limit of the first unpublished events, unless the claim of another relay
+// on some of them has not expired yet, in which case it claims none.
+func (d *Database) claimOutboxEvents(ctx context.Context, claimID string, limit int) ([]*StaffOutboxEvent, error) {
+	var events []*StaffOutboxEvent
+
+	err := d.runInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		if err := forUpdate(tx.NewSelect().
+			Model(&events).
+			Where("published_at IS NULL").
+			OrderExpr("event_id").
+			Limit(limit)).
+			Scan(ctx); err != nil {
+			return fmt.Errorf("failed to read outbox: %w", translateDBError(err))
+		}
+
+		now := time.Now()
+		eventIDs := make([]int64, 0, len(events))
+
+		for _, event := range events {
+			if event.ClaimedUntil.After(now) {
+				events = nil
+
+				return nil
+			}
+
+			eventIDs = append(eventIDs, event.EventID)
+		}
+
+		if len(eventIDs) == 0 {
+			return nil
+		}
+
+		if _, err := tx.NewUpdate().
+			Model((*StaffOutboxEvent)(nil)).
+			Set("claimed_by = ?", claimID).
+			Set("claimed_until = ?", now.Add(outboxClaimTimeout)).
+			Where("event_id IN (?)", bun.In(eventIDs)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to claim events: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// completeOutboxEvents marks the first published events of a claim as published, records the publish failure,
+// if any, on the event following them, and releases the claim on the other events.
+func (d *Database) completeOutboxEvents(ctx context.Context, claimID string, events []*StaffOutboxEvent,
+	published int, publishErr error,
+) error {
+	return d.runInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		if published > 0 {
+			eventIDs := make([]int64, 0, published)
+			for _, event := range events[:published] {
+				eventIDs = append(eventIDs, event.EventID)
+			}
+
+			if _, err := tx.NewUpdate().
+				Model((*StaffOutboxEvent)(nil)).
+				Set("published_at = ?", time.Now()).
+				Set("claimed_by = NULL").
+				Set("claimed_until = NULL").
+				Where("event_id IN (?)", bun.In(eventIDs)).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("failed to mark events as published: %w", translateDBError(err))
+			}
+		}
+
+		if publishErr != nil {
+			if _, err := tx.NewUpdate().
+				Model(events[published]).
+				Set("attempts = attempts + 1").
+				Set("last_error = ?", publishErr.Error()).
+				WherePK().
+				Exec(ctx); err != nil {
+				return fmt.Errorf("failed to record publish failure: %w", translateDBError(err))
+			}
+		}
+
+		if _, err := tx.NewUpdate().
+			Model((*StaffOutboxEvent)(nil)).
+			Set("claimed_by = NULL").
+			Set("claimed_until = NULL").
+			Where("claimed_by = ?", claimID).
+			Where("published_at IS NULL").
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to release events: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+}
+
+// PruneOutboxEvents removes the events published before the given time.
+func (d *Database) PruneOutboxEvents(ctx context.Context, publishedBefore time.Time) error {
+	ctx, cancel := d.withQueryTimeout(ctx)
+	defer cancel()
+
+	if _, err := d.db.NewDelete().
+		Model((*StaffOutboxEvent)(nil)).
+		Where("published_at < ?", publishedBefore).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to prune outbox: %w", translateDBError(err))
+	}
+
+	return nil
+}
+
+// DropUnpublishedOutboxEvents removes the events created before the given time that were never published,
+// and returns how many were removed. They are never delivered to the other services.
+func (d *Database) DropUnpublishedOutboxEvents(ctx context.Context, createdBefore time.Time) (int64, error) {
+	ctx, cancel := d.withQueryTimeout(ctx)
+	defer cancel()
+
+	res, err := d.db.NewDelete().
+		Model((*StaffOutboxEvent)(nil)).
+		Where("published_at IS NULL").
+		Where("created_at < ?", createdBefore).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to drop unpublished events: %w", translateDBError(err))
+	}
+
+	dropped, _ := res.RowsAffected()
+
+	return dropped, nil
+}
+
+// OutboxRelay publishes the events of the outbox through a Publisher.
+// Events are retried with exponential backoff until they are published,
+// so every committed change is delivered at least once.
+type OutboxRelay struct {
+	store     StaffStore
+	publisher Publisher
+}
+
+// NewOutboxRelay returns a relay publishing the events of the store.
+func NewOutboxRelay(store StaffStore, publisher Publisher) *OutboxRelay {
+	return &OutboxRelay{store: store, publisher: publisher}
+}
+
+// Run relays events until the context is canceled.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	failures := 0
+
+	for {
+		published, err := r.relayBatch(ctx)
+
+		wait := outboxPollInterval
+
+		switch {
+		case err != nil:
+			failures++
+			wait = outboxRetryPolicy.backoff(failures)
+			logger.Error(err, "Failed to relay staff events", "attempt", failures, "retryIn", wait)
+		case published == outboxBatchSize:
+			// More events are probably waiting.
+			failures, wait = 0, 0
+		default:
+			failures = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// OutboxPruner removes the events of the outbox published more than outboxRetention ago.
+type OutboxPruner struct {
+	store StaffStore
+	// dropUnpublished makes it also remove the events created more than outboxRetention ago
+	// that were never published.
+	dropUnpublished bool
+}
+
+// NewOutboxPruner returns a pruner of the events of the store. The outbox is shared by every replica,
+// so unpublished events are only dropped if dropUnpublished is set.
+func NewOutboxPruner(store StaffStore, dropUnpublished bool) *OutboxPruner {
+	return &OutboxPruner{store: store, dropUnpublished: dropUnpublished}
+}
+
+// Run prunes the events every outboxPruneInterval until the context is canceled.
+func (p *OutboxPruner) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+
+	ticker := time.NewTicker(outboxPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		before := time.Now().Add(-outboxRetention)
+		if err := p.store.PruneOutboxEvents(ctx, before); err != nil {
+			logger.Error(err, "Failed to prune staff events")
+		}
+
+		if p.dropUnpublished {
+			dropped, err := p.store.DropUnpublishedOutboxEvents(ctx, before)
+			if err != nil {
+				logger.Error(err, "Failed to drop unpublished staff events")
+			} else if dropped > 0 {
+				logger.Info("Dropped staff events that were never published", "count", dropped, "createdBefore", before)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayBatch publishes the next batch of events and returns how many were published.
+func (r *OutboxRelay) relayBatch(ctx context.Context) (int, error) {
+	published, err := r.store.RelayOutboxEvents(ctx, outboxBatchSize,
+		func(ctx context.Context, event *StaffOutboxEvent) error {
+			return r.publisher.Publish(ctx, event.message())
+		})
+	if published > 0 {
+		klog.FromContext(ctx).V(logLevelDebug).Info("Relayed staff events", "count", published)
+	}
+
+	return published, err
+}
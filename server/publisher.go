@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+)
+
+// Message is an event published to the other services.
+type Message struct {
+	// ID identifies the event, so consumers can ignore redelivered messages.
+	ID      string `json:"id"`
+	Subject string `json:"subject"`
+	// Data is the serialized StaffEvent.
+	Data []byte `json:"data"`
+}
+
+// Publisher delivers messages to the services subscribed to their subjects.
+type Publisher interface {
+	// Publish returns once the message is durably accepted for delivery.
+	// A message may be published again after an error, even if the error happened after it was accepted.
+	Publish(ctx context.Context, msg *Message) error
+}
+
+// MemoryPublisher keeps the published messages in memory.
+// It is safe for concurrent use.
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []*Message
+}
+
+// NewMemoryPublisher returns a MemoryPublisher without messages.
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// Publish implements Publisher.
+func (p *MemoryPublisher) Publish(_ context.Context, msg *Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.messages = append(p.messages, msg)
+
+	return nil
+}
+
+// Messages returns the messages published so far, in order.
+func (p *MemoryPublisher) Messages() []*Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return slices.Clone(p.messages)
+}
+
+// FilePublisher appends the published messages to a local file, one JSON object per line,
+// like a single-node message stream that consumers can tail.
+// It is safe for concurrent use.
+type FilePublisher struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFilePublisher returns a FilePublisher appending to the file at path, creating it if needed.
+func NewFilePublisher(path string) (*FilePublisher, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:mnd // owner read/write
+	if err != nil {
+		return nil, fmt.Errorf("failed to open events file: %w", err)
+	}
+
+	return &FilePublisher{file: file}, nil
+}
+
+// Publish implements Publisher. The message is synced to disk before Publish returns.
+func (p *FilePublisher) Publish(_ context.Context, msg *Message) error {
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	if err := p.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync events file: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the file.
+func (p *FilePublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.file.Close(); err != nil {
+		return fmt.Errorf("failed to close events file: %w", err)
+	}
+
+	return nil
+}
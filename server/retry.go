@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// retryPolicy is an exponential backoff with jitter for retrying calls failing with ErrDatabaseUnavailable.
+type retryPolicy struct {
+	// attempts is the maximal number of calls, or 0 to keep calling until the context is done.
+	attempts int
+	// initialBackoff is the wait before the first retry, doubled after every retry up to maxBackoff.
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// backoff returns a random wait before the given retry, which is at least half the exponential backoff.
+func (p retryPolicy) backoff(retry int) time.Duration {
+	backoff := p.maxBackoff
+	if retry < 32 { //nolint:mnd // avoid overflowing the shift
+		backoff = min(p.initialBackoff<<(retry-1), p.maxBackoff)
+	}
+
+	// Jitter spreads out the retries of several replicas.
+	return backoff/2 + rand.N(backoff/2+1) //nolint:gosec // jitter needs no cryptographic randomness
+}
+
+// retryUnavailable calls fn until it does not fail with ErrDatabaseUnavailable or the policy runs out of attempts,
+// waiting with jittered exponential backoff between the calls. It returns the last error of fn,
+// also if the context is done first.
+func retryUnavailable(ctx context.Context, policy retryPolicy, fn func(ctx context.Context) error) error {
+	for retry := 1; ; retry++ {
+		err := fn(ctx)
+		if !errors.Is(err, ErrDatabaseUnavailable) || (policy.attempts > 0 && retry >= policy.attempts) {
+			return err
+		}
+
+		backoff := policy.backoff(retry)
+		klog.FromContext(ctx).Info("Database is unavailable, retrying",
+			"attempt", retry, "retryIn", backoff, "err", err)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// searchNormalizer strips accents, as unaccent does for Latin letters.
+var searchNormalizer = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// hebrewFinalLetters maps the Hebrew final letters to their regular form.
+var hebrewFinalLetters = strings.NewReplacer("ך", "כ", "ם", "מ", "ן", "נ", "ף", "פ", "ץ", "צ")
+
+// searchNormalize normalizes text as the staff_search_normalize SQL function does:
+// it strips Hebrew niqqud and accents, lowercases and replaces Hebrew final letters with their regular form.
+func searchNormalize(value string) string {
+	value = strings.Map(func(r rune) rune {
+		if r >= '\u0591' && r <= '\u05C7' {
+			return -1
+		}
+
+		return r
+	}, value)
+
+	if unaccented, _, err := transform.String(searchNormalizer, value); err == nil {
+		value = unaccented
+	}
+
+	return hebrewFinalLetters.Replace(strings.ToLower(value))
+}
+
+// wordTrigrams returns the trigrams of every word of the text, each word padded as pg_trgm pads it.
+func wordTrigrams(text string) [][]string {
+	words := strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+	trigrams := make([][]string, 0, len(words))
+
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+
+		wordTrigrams := make([]string, 0, len(padded)-2) //nolint:mnd // a trigram per character and padding
+		for i := range len(padded) - 2 {
+			wordTrigrams = append(wordTrigrams, string(padded[i:i+3]))
+		}
+
+		trigrams = append(trigrams, wordTrigrams)
+	}
+
+	return trigrams
+}
+
+// wordSimilarity approximates the word_similarity of pg_trgm: the greatest similarity between the trigrams
+// of the query and those of a run of consecutive words of the document.
+func wordSimilarity(query, document string) float64 {
+	queryTrigrams := make(map[string]struct{})
+	for _, word := range wordTrigrams(query) {
+		for _, trigram := range word {
+			queryTrigrams[trigram] = struct{}{}
+		}
+	}
+
+	if len(queryTrigrams) == 0 {
+		return 0
+	}
+
+	words := wordTrigrams(document)
+	best := 0.0
+
+	for first := range words {
+		extent := make(map[string]struct{})
+
+		for _, word := range words[first:] {
+			for _, trigram := range word {
+				extent[trigram] = struct{}{}
+			}
+
+			common := 0
+
+			for trigram := range extent {
+				if _, ok := queryTrigrams[trigram]; ok {
+					common++
+				}
+			}
+
+			best = max(best, float64(common)/float64(len(queryTrigrams)+len(extent)-common))
+		}
+	}
+
+	return best
+}
@@ -3,17 +3,19 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
 
 	spb "github.com/BetterGR/staff-microservice/protos"
 	ms "github.com/TekClinic/MicroService-Lib"
 	"github.com/joho/godotenv"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/metadata"
 	"k8s.io/klog/v2"
 )
 
@@ -27,23 +29,21 @@ const (
 // StaffServer is an implementation of GRPC Staff microservice.
 type StaffServer struct {
 	ms.BaseServiceServer
-	db *Database
+	store         StaffStore
+	watcher       *StaffWatcher
+	tokenVerifier *TokenVerifier
 	spb.UnimplementedStaffServiceServer
 	Claims ms.Claims
 }
 
 // VerifyToken returns the injected Claims instead of the default.
-func (s *StaffServer) VerifyToken(ctx context.Context, token string) error {
+func (s *StaffServer) VerifyToken(ctx context.Context, token string) (ms.Claims, error) {
 	if s.Claims != nil {
-		return nil
-	}
-
-	// Default behavior.
-	if _, err := s.BaseServiceServer.VerifyToken(ctx, token); err != nil {
-		return fmt.Errorf("failed to verify token: %w", err)
+		return s.Claims, nil
 	}
 
-	return nil
+	// Default behavior, which also reads the subject of the token.
+	return s.tokenVerifier.Verify(ctx, token)
 }
 
 func initStaffMicroserviceServer() (*StaffServer, error) {
@@ -52,14 +52,16 @@ func initStaffMicroserviceServer() (*StaffServer, error) {
 		return nil, fmt.Errorf("failed to create base service: %w", err)
 	}
 
-	database, err := InitializeDatabase()
+	store, err := InitializeDatabase()
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
 
 	return &StaffServer{
 		BaseServiceServer:               base,
-		db:                              database,
+		store:                           store,
+		watcher:                         NewStaffWatcher(store),
+		tokenVerifier:                   NewTokenVerifier(os.Getenv("AUTH_ISSUER")),
 		UnimplementedStaffServiceServer: spb.UnimplementedStaffServiceServer{},
 	}, nil
 }
@@ -68,101 +70,150 @@ func initStaffMicroserviceServer() (*StaffServer, error) {
 func (s *StaffServer) GetStaffMember(ctx context.Context,
 	req *spb.GetStaffMemberRequest,
 ) (*spb.GetStaffMemberResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_GetStaffMember_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := caller.authorizeIncludeDeleted(req.GetIncludeDeleted()); err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received GetStaffMember request", "staffId", req.GetStaffID())
 
-	staff, err := s.db.GetStaffMember(ctx, req.GetStaffID())
+	staff, err := s.store.GetStaffMember(ctx, req.GetStaffID(), req.GetIncludeDeleted())
 	if err != nil {
-		return nil, fmt.Errorf("staff member not found: %w",
-			status.Error(codes.NotFound, err.Error()))
-	}
-
-	staffMember := &spb.StaffMember{
-		StaffID:     staff.StaffID,
-		FirstName:   staff.FirstName,
-		LastName:    staff.LastName,
-		Email:       staff.Email,
-		PhoneNumber: staff.PhoneNumber,
-		Title:       staff.Title,
-		Office:      staff.Office,
+		return nil, statusError(ctx, "failed to get staff member", err)
 	}
 
-	return &spb.GetStaffMemberResponse{StaffMember: staffMember}, nil
+	return &spb.GetStaffMemberResponse{StaffMember: caller.view(staffMemberToProto(staff))}, nil
 }
 
 // CreateStaffMember creates a new StaffMember with the given details and returns them.
 func (s *StaffServer) CreateStaffMember(ctx context.Context,
 	req *spb.CreateStaffMemberRequest,
 ) (*spb.CreateStaffMemberResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_CreateStaffMember_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := caller.authorizeStaffID(req.GetStaffMember().GetStaffID()); err != nil {
+		return nil, err
 	}
 
+	if err := validateNewStaffMember(req.GetStaffMember()); err != nil {
+		return nil, err
+	}
+
+	ctx = withActor(ctx, caller.subject)
+
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received CreateStaffMember request",
 		"firstName", req.GetStaffMember().GetFirstName(), "secondName", req.GetStaffMember().GetLastName())
 
-	if _, err := s.db.AddStaffMember(ctx, req.GetStaffMember()); err != nil {
-		return nil, fmt.Errorf("failed to create staff member: %w",
-			status.Error(codes.Internal, err.Error()))
+	created, err := s.store.AddStaffMember(ctx, req.GetStaffMember())
+	if err != nil {
+		return nil, statusError(ctx, "failed to create staff member", err)
 	}
 
-	return &spb.CreateStaffMemberResponse{StaffMember: req.GetStaffMember()}, nil
+	return &spb.CreateStaffMemberResponse{StaffMember: staffMemberToProto(created)}, nil
 }
 
 // UpdateStaffMember updates the given StaffMember and returns them after the update.
 func (s *StaffServer) UpdateStaffMember(ctx context.Context,
 	req *spb.UpdateStaffMemberRequest,
 ) (*spb.UpdateStaffMemberResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_UpdateStaffMember_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := caller.authorizeUpdate(req.GetStaffMember(), req.GetUpdateMask().GetPaths()); err != nil {
+		return nil, err
+	}
+
+	if err := validateStaffMemberUpdate(req.GetStaffMember(), req.GetUpdateMask().GetPaths()); err != nil {
+		return nil, err
 	}
 
+	ctx = withActor(ctx, caller.subject)
+
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received UpdateStaffMember request",
 		"firstName", req.GetStaffMember().GetFirstName(), "secondName", req.GetStaffMember().GetLastName())
 
-	updatedStaff, err := s.db.UpdateStaffMember(ctx, req.GetStaffMember())
+	updatedStaff, err := s.store.UpdateStaffMember(ctx, req.GetStaffMember(),
+		req.GetUpdateMask().GetPaths(), req.GetEtag())
 	if err != nil {
-		return nil, fmt.Errorf("failed to update staff member: %w",
-			status.Error(codes.Internal, err.Error()))
+		return nil, statusError(ctx, "failed to update staff member", err)
+	}
+
+	return &spb.UpdateStaffMemberResponse{StaffMember: staffMemberToProto(updatedStaff)}, nil
+}
+
+// UpsertStaffMember creates a StaffMember, or updates the StaffMember with the same staffID or email,
+// and returns them together with whether they were created or updated.
+func (s *StaffServer) UpsertStaffMember(ctx context.Context,
+	req *spb.UpsertStaffMemberRequest,
+) (*spb.UpsertStaffMemberResponse, error) {
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_UpsertStaffMember_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := caller.authorizeStaffID(req.GetStaffMember().GetStaffID()); err != nil {
+		return nil, err
+	}
+
+	if err := validateNewStaffMember(req.GetStaffMember()); err != nil {
+		return nil, err
 	}
 
-	staff := &spb.StaffMember{
-		StaffID:     updatedStaff.StaffID,
-		FirstName:   updatedStaff.FirstName,
-		LastName:    updatedStaff.LastName,
-		Email:       updatedStaff.Email,
-		PhoneNumber: updatedStaff.PhoneNumber,
-		Title:       updatedStaff.Title,
-		Office:      updatedStaff.Office,
+	if err := validateUpsertTarget(req.GetStaffMember(), req.GetOnConflict()); err != nil {
+		return nil, err
 	}
 
-	return &spb.UpdateStaffMemberResponse{StaffMember: staff}, nil
+	ctx = withActor(ctx, caller.subject)
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received UpsertStaffMember request",
+		"firstName", req.GetStaffMember().GetFirstName(), "secondName", req.GetStaffMember().GetLastName(),
+		"onConflict", req.GetOnConflict())
+
+	target := UpsertOnStaffID
+	if req.GetOnConflict() == spb.UpsertConflictTarget_UPSERT_ON_EMAIL {
+		target = UpsertOnEmail
+	}
+
+	upserted, result, err := s.store.UpsertStaffMember(ctx, req.GetStaffMember(), target)
+	if err != nil {
+		return nil, statusError(ctx, "failed to upsert staff member", err)
+	}
+
+	return &spb.UpsertStaffMemberResponse{
+		StaffMember: staffMemberToProto(upserted),
+		Result:      upsertResults[result],
+	}, nil
 }
 
 // DeleteStaffMember deletes the StaffMember from the system.
 func (s *StaffServer) DeleteStaffMember(ctx context.Context,
 	req *spb.DeleteStaffMemberRequest,
 ) (*spb.DeleteStaffMemberResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_DeleteStaffMember_FullMethodName)
+	if err != nil {
+		return nil, err
 	}
 
+	ctx = withActor(ctx, caller.subject)
+
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received DeleteStaffMember request", "staffId", req.GetStaffID())
 
-	if err := s.db.DeleteStaffMember(ctx, req.GetStaffID()); err != nil {
-		return nil, fmt.Errorf("failed to delete staff member: %w",
-			status.Error(codes.Internal, err.Error()))
+	if err := s.store.DeleteStaffMember(ctx, req.GetStaffID(), req.GetEtag()); err != nil {
+		return nil, statusError(ctx, "failed to delete staff member", err)
 	}
 
 	logger.V(logLevelDebug).Info("Deleted", "staffId", req.GetStaffID())
@@ -170,14 +221,544 @@ func (s *StaffServer) DeleteStaffMember(ctx context.Context,
 	return &spb.DeleteStaffMemberResponse{}, nil
 }
 
+// RestoreStaffMember restores a deleted StaffMember and returns them.
+func (s *StaffServer) RestoreStaffMember(ctx context.Context,
+	req *spb.RestoreStaffMemberRequest,
+) (*spb.RestoreStaffMemberResponse, error) {
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_RestoreStaffMember_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withActor(ctx, caller.subject)
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received RestoreStaffMember request", "staffId", req.GetStaffID())
+
+	restored, err := s.store.RestoreStaffMember(ctx, req.GetStaffID())
+	if err != nil {
+		return nil, statusError(ctx, "failed to restore staff member", err)
+	}
+
+	return &spb.RestoreStaffMemberResponse{StaffMember: staffMemberToProto(restored)}, nil
+}
+
+// PurgeStaffMember permanently removes a deleted StaffMember from the system.
+func (s *StaffServer) PurgeStaffMember(ctx context.Context,
+	req *spb.PurgeStaffMemberRequest,
+) (*spb.PurgeStaffMemberResponse, error) {
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_PurgeStaffMember_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withActor(ctx, caller.subject)
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received PurgeStaffMember request", "staffId", req.GetStaffID())
+
+	if err := s.store.PurgeStaffMember(ctx, req.GetStaffID()); err != nil {
+		return nil, statusError(ctx, "failed to purge staff member", err)
+	}
+
+	logger.V(logLevelDebug).Info("Purged", "staffId", req.GetStaffID())
+
+	return &spb.PurgeStaffMemberResponse{}, nil
+}
+
+// ListStaffMembers returns a page of StaffMembers matching the given filters.
+func (s *StaffServer) ListStaffMembers(ctx context.Context,
+	req *spb.ListStaffMembersRequest,
+) (*spb.ListStaffMembersResponse, error) {
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_ListStaffMembers_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := caller.authorizeIncludeDeleted(req.GetIncludeDeleted()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ListStaffMembers request",
+		"pageSize", req.GetPageSize(), "title", req.GetTitle(), "office", req.GetOffice())
+
+	orderBy := OrderByLastName
+	if req.GetOrderBy() == spb.StaffMemberOrderBy_ORDER_BY_CREATED_AT {
+		orderBy = OrderByCreatedAt
+	}
+
+	page, err := s.store.ListStaffMembers(ctx, &ListStaffMembersParams{
+		Filter: StaffMemberFilter{
+			Title:          req.GetTitle(),
+			Office:         req.GetOffice(),
+			IncludeDeleted: req.GetIncludeDeleted(),
+		},
+		OrderBy:    orderBy,
+		Descending: req.GetDescending(),
+		PageSize:   int(req.GetPageSize()),
+		PageToken:  req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, statusError(ctx, "failed to list staff members", err)
+	}
+
+	staffMembers := make([]*spb.StaffMember, 0, len(page.StaffMembers))
+	for _, staff := range page.StaffMembers {
+		staffMembers = append(staffMembers, caller.view(staffMemberToProto(staff)))
+	}
+
+	return &spb.ListStaffMembersResponse{
+		StaffMembers:  staffMembers,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    int64(page.TotalCount),
+	}, nil
+}
+
+// SearchStaffMembers returns the StaffMembers matching the given query, best match first.
+func (s *StaffServer) SearchStaffMembers(ctx context.Context,
+	req *spb.SearchStaffMembersRequest,
+) (*spb.SearchStaffMembersResponse, error) {
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_SearchStaffMembers_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received SearchStaffMembers request", "query", req.GetQuery())
+
+	matches, err := s.store.SearchStaffMembers(ctx, req.GetQuery(), int(req.GetLimit()))
+	if err != nil {
+		return nil, statusError(ctx, "failed to search staff members", err)
+	}
+
+	results := make([]*spb.StaffMemberSearchResult, 0, len(matches))
+	for _, match := range matches {
+		results = append(results, &spb.StaffMemberSearchResult{
+			StaffMember: caller.view(staffMemberToProto(&match.StaffMember)),
+			Score:       match.Score,
+		})
+	}
+
+	return &spb.SearchStaffMembersResponse{Results: results}, nil
+}
+
+// ListStaffAuditEvents returns a page of the audit log matching the given filters, newest first.
+func (s *StaffServer) ListStaffAuditEvents(ctx context.Context,
+	req *spb.ListStaffAuditEventsRequest,
+) (*spb.ListStaffAuditEventsResponse, error) {
+	if _, err := s.authorize(ctx, req.GetToken(), spb.StaffService_ListStaffAuditEvents_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ListStaffAuditEvents request",
+		"staffId", req.GetStaffID(), "actor", req.GetActor())
+
+	page, err := s.store.ListStaffAuditEvents(ctx, StaffAuditFilter{
+		StaffID: req.GetStaffID(),
+		Actor:   req.GetActor(),
+		From:    optionalTime(req.GetFrom()),
+		To:      optionalTime(req.GetTo()),
+	}, int(req.GetPageSize()), req.GetPageToken())
+	if err != nil {
+		return nil, statusError(ctx, "failed to list audit events", err)
+	}
+
+	events := make([]*spb.StaffAuditEvent, 0, len(page.Events))
+	for _, event := range page.Events {
+		events = append(events, auditEventToProto(event))
+	}
+
+	return &spb.ListStaffAuditEventsResponse{Events: events, NextPageToken: page.NextPageToken}, nil
+}
+
+// WatchStaffMembers streams the changes to the watched StaffMembers as they are committed.
+func (s *StaffServer) WatchStaffMembers(req *spb.WatchStaffMembersRequest,
+	stream spb.StaffService_WatchStaffMembersServer,
+) error {
+	ctx := stream.Context()
+
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_WatchStaffMembers_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received WatchStaffMembers request",
+		"staffIds", req.GetStaffIDs(), "offices", req.GetOffices(), "resumeRevision", req.GetResumeRevision())
+
+	filter := newStaffWatchFilter(req.GetStaffIDs(), req.GetOffices())
+	send := func(change *staffChange) error {
+		if !filter.matches(change) {
+			return nil
+		}
+
+		event := change.event
+		if view := caller.view(event.GetStaffMember()); view != event.GetStaffMember() {
+			event = &spb.StaffEvent{Type: event.GetType(), StaffMember: view, OccurredAt: event.GetOccurredAt()}
+		}
+
+		if err := stream.Send(&spb.WatchStaffMembersResponse{Event: event, Revision: change.revision}); err != nil {
+			return fmt.Errorf("failed to send staff change: %w", err)
+		}
+
+		return nil
+	}
+
+	// Subscribe before catching up, so that no change is missed in between.
+	sub := s.watcher.subscribe()
+	defer s.watcher.unsubscribe(sub)
+
+	// Let the client know the stream is established.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return fmt.Errorf("failed to send header: %w", err)
+	}
+
+	// sent is the position of the last change caught up on. The subscription also receives
+	// the changes up to it that were broadcast while catching up.
+	var sent staffEventPosition
+
+	if req.GetResumeRevision() != "" {
+		if sent, err = parseRevision(req.GetResumeRevision()); err != nil {
+			return statusError(ctx, "failed to watch staff members", err)
+		}
+
+		for {
+			events, err := s.store.StaffEventsAfter(ctx, sent, watchCatchUpBatchSize)
+			if err != nil {
+				return statusError(ctx, "failed to watch staff members", err)
+			}
+
+			for _, event := range events {
+				change, err := newStaffChange(event)
+				if err != nil {
+					return statusError(ctx, "failed to watch staff members", err)
+				}
+
+				if err := send(change); err != nil {
+					return err
+				}
+
+				sent = change.position
+			}
+
+			if len(events) < watchCatchUpBatchSize {
+				break
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.watcher.Done():
+			// The client resumes the stream on another server from the last revision it received.
+			return statusError(ctx, "failed to watch staff members", ErrServerShuttingDown)
+		case change, ok := <-sub.changes:
+			if !ok {
+				return statusError(ctx, "failed to watch staff members", ErrWatcherTooSlow)
+			}
+
+			if !change.position.after(sent) {
+				continue
+			}
+
+			if err := send(change); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// BatchGetStaffMembers returns the StaffMembers with the given ids, in the same order, and the ids that were not found.
+func (s *StaffServer) BatchGetStaffMembers(ctx context.Context,
+	req *spb.BatchGetStaffMembersRequest,
+) (*spb.BatchGetStaffMembersResponse, error) {
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_BatchGetStaffMembers_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := caller.authorizeIncludeDeleted(req.GetIncludeDeleted()); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received BatchGetStaffMembers request", "count", len(req.GetStaffIDs()))
+
+	found, err := s.store.GetStaffMembers(ctx, req.GetStaffIDs(), req.GetIncludeDeleted())
+	if err != nil {
+		return nil, statusError(ctx, "failed to get staff members", err)
+	}
+
+	byID := make(map[string]*StaffMember, len(found))
+	for _, staff := range found {
+		byID[staff.StaffID] = staff
+	}
+
+	resp := &spb.BatchGetStaffMembersResponse{}
+
+	for _, staffID := range req.GetStaffIDs() {
+		if staff, ok := byID[staffID]; ok {
+			resp.StaffMembers = append(resp.StaffMembers, caller.view(staffMemberToProto(staff)))
+		} else {
+			resp.MissingStaffIDs = append(resp.MissingStaffIDs, staffID)
+		}
+	}
+
+	return resp, nil
+}
+
+// BatchCreateStaffMembers creates the given StaffMembers in a single transaction.
+func (s *StaffServer) BatchCreateStaffMembers(ctx context.Context,
+	req *spb.BatchCreateStaffMembersRequest,
+) (*spb.BatchCreateStaffMembersResponse, error) {
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_BatchCreateStaffMembers_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withActor(ctx, caller.subject)
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received BatchCreateStaffMembers request",
+		"count", len(req.GetStaffMembers()), "mode", req.GetMode())
+
+	results, err := runBatchItems(ctx, "failed to create staff members", "staffMembers",
+		req.GetMode(), req.GetStaffMembers(),
+		func(staff *spb.StaffMember) error {
+			if err := caller.authorizeStaffID(staff.GetStaffID()); err != nil {
+				return err
+			}
+
+			return validateNewStaffMember(staff)
+		},
+		func(staffMembers []*spb.StaffMember, mode BatchMode) ([]BatchItemResult, error) {
+			return s.store.BatchAddStaffMembers(ctx, staffMembers, mode)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &spb.BatchCreateStaffMembersResponse{Results: results}, nil
+}
+
+// BatchUpdateStaffMembers applies the given updates in a single transaction.
+func (s *StaffServer) BatchUpdateStaffMembers(ctx context.Context,
+	req *spb.BatchUpdateStaffMembersRequest,
+) (*spb.BatchUpdateStaffMembersResponse, error) {
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_BatchUpdateStaffMembers_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withActor(ctx, caller.subject)
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received BatchUpdateStaffMembers request",
+		"count", len(req.GetUpdates()), "mode", req.GetMode())
+
+	results, err := runBatchItems(ctx, "failed to update staff members", "updates",
+		req.GetMode(), req.GetUpdates(),
+		func(update *spb.StaffMemberUpdate) error {
+			if err := caller.authorizeUpdate(update.GetStaffMember(), update.GetUpdateMask().GetPaths()); err != nil {
+				return err
+			}
+
+			return validateStaffMemberUpdate(update.GetStaffMember(), update.GetUpdateMask().GetPaths())
+		},
+		func(updates []*spb.StaffMemberUpdate, mode BatchMode) ([]BatchItemResult, error) {
+			items := make([]StaffMemberUpdate, 0, len(updates))
+			for _, update := range updates {
+				items = append(items, StaffMemberUpdate{
+					StaffMember: update.GetStaffMember(),
+					UpdateMask:  update.GetUpdateMask().GetPaths(),
+					Etag:        update.GetEtag(),
+				})
+			}
+
+			return s.store.BatchUpdateStaffMembers(ctx, items, mode)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &spb.BatchUpdateStaffMembersResponse{Results: results}, nil
+}
+
+// BatchDeleteStaffMembers deletes the given StaffMembers in a single transaction.
+func (s *StaffServer) BatchDeleteStaffMembers(ctx context.Context,
+	req *spb.BatchDeleteStaffMembersRequest,
+) (*spb.BatchDeleteStaffMembersResponse, error) {
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_BatchDeleteStaffMembers_FullMethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withActor(ctx, caller.subject)
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received BatchDeleteStaffMembers request",
+		"count", len(req.GetDeletions()), "mode", req.GetMode())
+
+	results, err := runBatchItems(ctx, "failed to delete staff members", "deletions",
+		req.GetMode(), req.GetDeletions(),
+		func(*spb.StaffMemberDeletion) error { return nil },
+		func(deletions []*spb.StaffMemberDeletion, mode BatchMode) ([]BatchItemResult, error) {
+			items := make([]StaffMemberDeletion, 0, len(deletions))
+			for _, deletion := range deletions {
+				items = append(items, StaffMemberDeletion{StaffID: deletion.GetStaffID(), Etag: deletion.GetEtag()})
+			}
+
+			return s.store.BatchDeleteStaffMembers(ctx, items, mode)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &spb.BatchDeleteStaffMembersResponse{Results: results}, nil
+}
+
+// ImportStaffMembers creates or updates the StaffMembers of a CSV or XLSX roster and reports the outcome of every row.
+func (s *StaffServer) ImportStaffMembers(stream spb.StaffService_ImportStaffMembersServer) error {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return statusError(ctx, "failed to import staff members", fmt.Errorf("%w: no roster was sent", ErrInvalidRoster))
+	} else if err != nil {
+		return statusError(ctx, "failed to import staff members", fmt.Errorf("failed to receive roster: %w", err))
+	}
+
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_ImportStaffMembers_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	ctx = withActor(ctx, caller.subject)
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ImportStaffMembers request",
+		"format", req.GetFormat(), "matchBy", req.GetMatchBy(), "dryRun", req.GetDryRun())
+
+	roster, err := receiveRoster(stream, req)
+	if err != nil {
+		return statusError(ctx, "failed to import staff members", err)
+	}
+
+	rosterRows, err := parseRoster(req.GetFormat(), roster, req.GetSheet())
+	if err != nil {
+		return statusError(ctx, "failed to import staff members", err)
+	}
+
+	matchBy := ImportMatchByEmail
+	if req.GetMatchBy() == spb.ImportMatchBy_IMPORT_MATCH_BY_STAFF_ID {
+		matchBy = ImportMatchByStaffID
+	}
+
+	rows, fields, err := importRows(rosterRows, req.GetColumns(), matchBy)
+	if err != nil {
+		return statusError(ctx, "failed to import staff members", err)
+	}
+
+	opts := &ImportOptions{Fields: fields, MatchBy: matchBy, DryRun: req.GetDryRun()}
+	opts.Check = func(staff *spb.StaffMember, existing *StaffMember) error {
+		if existing == nil {
+			if err := caller.authorizeStaffID(staff.GetStaffID()); err != nil {
+				return err
+			}
+
+			return validateNewStaffMember(staff)
+		}
+
+		return validateStaffMemberUpdate(staff, opts.updateMask())
+	}
+
+	results, err := s.store.ImportStaffMembers(ctx, rows, opts)
+	if err != nil {
+		return statusError(ctx, "failed to import staff members", err)
+	}
+
+	report := importReport(ctx, results, req.GetDryRun())
+	logger.V(logLevelDebug).Info("Imported staff members", "created", report.GetCreated(),
+		"updated", report.GetUpdated(), "skipped", report.GetSkipped(), "failed", report.GetFailed())
+
+	if err := stream.SendAndClose(report); err != nil {
+		return fmt.Errorf("failed to send import report: %w", err)
+	}
+
+	return nil
+}
+
+// ExportStaffMembers streams the StaffMembers matching the given filters as a CSV, NDJSON or vCard file.
+func (s *StaffServer) ExportStaffMembers(req *spb.ExportStaffMembersRequest,
+	stream spb.StaffService_ExportStaffMembersServer,
+) error {
+	ctx := stream.Context()
+
+	caller, err := s.authorize(ctx, req.GetToken(), spb.StaffService_ExportStaffMembers_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	if err := caller.authorizeIncludeDeleted(req.GetIncludeDeleted()); err != nil {
+		return err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ExportStaffMembers request",
+		"format", req.GetFormat(), "title", req.GetTitle(), "office", req.GetOffice())
+
+	out := &exportStream{stream: stream}
+
+	encoder, err := newStaffEncoder(req.GetFormat(), out)
+	if err != nil {
+		return statusError(ctx, "failed to export staff members", err)
+	}
+
+	filter := StaffMemberFilter{
+		Title:          req.GetTitle(),
+		Office:         req.GetOffice(),
+		IncludeDeleted: req.GetIncludeDeleted(),
+	}
+
+	err = s.store.ForEachStaffMember(ctx, filter, func(staff *StaffMember) error {
+		if err := encoder.Encode(caller.view(staffMemberToProto(staff))); err != nil {
+			return err
+		}
+
+		return out.flush(false)
+	})
+	if err != nil {
+		return statusError(ctx, "failed to export staff members", err)
+	}
+
+	if err := out.flush(true); err != nil {
+		return statusError(ctx, "failed to export staff members", err)
+	}
+
+	return nil
+}
+
 // main StaffServer function.
 func main() {
 	// init klog
 	klog.InitFlags(nil)
 	flag.Parse()
 
+	// run a subcommand instead of the server if one is named after the global flags
+	runCommand(flag.Args())
+
 	if err := godotenv.Load(); err != nil {
-	    klog.Warning("Warning: No .env file loaded, proceeding with environment variables only")
+		klog.Warning("Warning: No .env file loaded, proceeding with environment variables only")
+	}
+
+	// trace the RPCs and the queries
+	shutdownTracing, err := setupTracing(context.Background())
+	if err != nil {
+		klog.Fatalf("Failed to set up tracing: %v", err)
 	}
 
 	// init the StaffServer
@@ -186,6 +767,27 @@ func main() {
 		klog.Fatalf("Failed to init StaffServer: %v", err)
 	}
 
+	// relay the staff events to the other services
+	var (
+		publisher     Publisher
+		filePublisher *FilePublisher
+	)
+
+	if eventsFile := os.Getenv("EVENTS_FILE"); eventsFile != "" {
+		if filePublisher, err = NewFilePublisher(eventsFile); err != nil {
+			klog.Fatalf("Failed to create publisher: %v", err)
+		}
+
+		publisher = filePublisher
+	} else if !*dropUnpublishedEvents {
+		klog.Warning("EVENTS_FILE is not set, staff events are kept in the outbox until a publisher is configured")
+	}
+
+	if *dropUnpublishedEvents {
+		klog.Warningf("Staff events that are not published within %v are dropped from the outbox of every replica",
+			outboxRetention)
+	}
+
 	// create a listener on port 'address'
 	address := "localhost:" + os.Getenv("GRPC_PORT")
 
@@ -194,13 +796,33 @@ func main() {
 		klog.Fatalf("Failed to listen: %v", err)
 	}
 
+	// shut down gracefully on SIGINT or SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
 	klog.V(logLevelDebug).Info("Starting StaffServer on port: ", address)
-	// create a grpc StaffServer
-	grpcServer := grpc.NewServer()
-	spb.RegisterStaffServiceServer(grpcServer, server)
 
 	// serve the grpc StaffServer
-	if err := grpcServer.Serve(lis); err != nil {
-		klog.Fatalf("Failed to serve: %v", err)
+	err = runServer(ctx, server, lis, runOptions{
+		publisher:       publisher,
+		dropUnpublished: *dropUnpublishedEvents,
+		shutdownTimeout: *shutdownTimeout,
+		metricsAddress:  *metricsAddress,
+	})
+	stop()
+
+	if filePublisher != nil {
+		err = errors.Join(err, filePublisher.Close())
 	}
+
+	// flush the spans of the shutdown
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), *shutdownTimeout)
+	err = errors.Join(err, shutdownTracing(flushCtx))
+
+	cancelFlush()
+
+	if err != nil {
+		klog.Fatalf("StaffServer stopped: %v", err)
+	}
+
+	klog.Info("StaffServer stopped")
 }
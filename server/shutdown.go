@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"k8s.io/klog/v2"
+)
+
+var ErrServerShuttingDown = errors.New("server is shutting down")
+
+// shutdownTimeout bounds the time in-flight RPCs are given to complete on shutdown.
+var shutdownTimeout = flag.Duration("shutdown-timeout", 30*time.Second,
+	"how long in-flight RPCs may run on shutdown before they are canceled")
+
+// runOptions configures runServer.
+type runOptions struct {
+	// publisher relays the staff events of the outbox, unless it is nil.
+	publisher Publisher
+	// dropUnpublished removes the staff events that were not published within the outbox retention.
+	dropUnpublished bool
+	// shutdownTimeout bounds the time in-flight RPCs are given to complete on shutdown.
+	shutdownTimeout time.Duration
+	// metricsAddress is the address the /metrics endpoint is served on, unless it is empty.
+	metricsAddress string
+}
+
+// runServer serves the StaffServer on the listener until the context is canceled, then shuts down:
+//
+//  1. the health service reports NOT_SERVING and the watch streams end, so that clients move to other servers;
+//  2. the server stops accepting RPCs and waits up to the shutdown timeout for the in-flight ones,
+//     after which they are canceled;
+//  3. the outbox relay and pruner, the metrics endpoint and the other background workers stop;
+//  4. the store is closed.
+//
+// It returns once the server is shut down, with the error that made it stop serving, if any.
+func runServer(ctx context.Context, server *StaffServer, lis net.Listener, opts runOptions) error {
+	// The background workers outlive the context, as the in-flight RPCs may still use them.
+	workersCtx, stopWorkers := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopWorkers()
+
+	var workers sync.WaitGroup
+
+	startWorker := func(run func(ctx context.Context)) {
+		workers.Add(1)
+
+		go func() {
+			defer workers.Done()
+			run(workersCtx)
+		}()
+	}
+
+	// measure the RPCs and the queries
+	metrics := NewMetrics()
+	if err := metrics.InstrumentStore(server.store); err != nil {
+		return errors.Join(err, closeStore(server))
+	}
+
+	if opts.metricsAddress != "" {
+		metricsLis, err := net.Listen(connectionProtocol, opts.metricsAddress)
+		if err != nil {
+			return errors.Join(fmt.Errorf("failed to listen for metrics: %w", err), closeStore(server))
+		}
+
+		startWorker(func(ctx context.Context) { metrics.serveMetrics(ctx, metricsLis) })
+	}
+
+	// stream the staff events to the watchers
+	watcherCtx, stopWatcher := context.WithCancel(workersCtx)
+	defer stopWatcher()
+
+	if err := server.watcher.Start(watcherCtx); err != nil {
+		stopWorkers()
+		workers.Wait()
+
+		return errors.Join(fmt.Errorf("failed to start watching staff events: %w", err), closeStore(server))
+	}
+
+	// relay the staff events to the other services
+	if opts.publisher != nil {
+		startWorker(NewOutboxRelay(server.store, opts.publisher).Run)
+	}
+
+	startWorker(NewOutboxPruner(server.store, opts.dropUnpublished).Run)
+
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor()))
+	spb.RegisterStaffServiceServer(grpcServer, server)
+
+	// report the liveness and readiness of the server
+	healthChecker := NewHealthChecker(server.store, *healthCheckInterval)
+	healthChecker.Register(grpcServer)
+	startWorker(healthChecker.Run)
+
+	served := make(chan error, 1)
+
+	go func() {
+		served <- grpcServer.Serve(lis)
+	}()
+
+	var err error
+
+	select {
+	case <-ctx.Done():
+		klog.Info("Shutting down StaffServer")
+	case err = <-served:
+		err = fmt.Errorf("failed to serve: %w", err)
+	}
+
+	healthChecker.Shutdown()
+	stopWatcher()
+	<-server.watcher.Done()
+
+	if !gracefulStop(grpcServer, opts.shutdownTimeout) {
+		klog.Warningf("In-flight RPCs did not complete within %v and were canceled", opts.shutdownTimeout)
+	}
+
+	stopWorkers()
+	workers.Wait()
+
+	return errors.Join(err, closeStore(server))
+}
+
+// gracefulStop stops the gRPC server from accepting RPCs and waits for the in-flight ones to complete,
+// for up to timeout, after which they are canceled. It reports whether they all completed.
+func gracefulStop(grpcServer *grpc.Server, timeout time.Duration) bool {
+	stopped := make(chan struct{})
+
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+		return true
+	case <-timer.C:
+		grpcServer.Stop()
+		<-stopped
+
+		return false
+	}
+}
+
+// closeStore closes the store of the server.
+func closeStore(server *StaffServer) error {
+	if err := server.store.Close(); err != nil {
+		return fmt.Errorf("failed to close store: %w", err)
+	}
+
+	klog.V(logLevelDebug).Info("Closed the store")
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const (
+	// sqliteDSNScheme is the scheme of the DSN selecting a SQLite database file, as in sqlite://staff.db.
+	sqliteDSNScheme = "sqlite:"
+	// memoryDSNScheme is the scheme of the DSN selecting an in-memory SQLite database,
+	// which forgets everything when the server stops.
+	memoryDSNScheme = "memory:"
+	// sqliteBusyTimeout is how long a SQLite connection waits for the write lock held by another one.
+	sqliteBusyTimeout = 10 * time.Second
+	// sqliteEventPollInterval is how often a SQLite listener polls the outbox for new staff events.
+	sqliteEventPollInterval = 200 * time.Millisecond
+)
+
+// uniqueColumnFields maps the unique columns of staff_members to the StaffMember fields they hold.
+// SQLite names the columns of a violated constraint rather than the constraint.
+var uniqueColumnFields = map[string]string{
+	"staff_id":     "staffID",
+	"email":        "email",
+	"phone_number": "phoneNumber",
+	"subject":      "subject",
+}
+
+// isSQLiteDSN reports whether the DSN selects a SQLite database file.
+func isSQLiteDSN(dsn string) bool {
+	return strings.HasPrefix(dsn, sqliteDSNScheme)
+}
+
+// isMemoryDSN reports whether the DSN selects the in-memory database.
+func isMemoryDSN(dsn string) bool {
+	return strings.HasPrefix(dsn, memoryDSNScheme)
+}
+
+// sqliteDSN converts a sqlite://<path>?<pragmas> DSN to the DSN of the SQLite driver.
+// Transactions take the write lock when they begin, so that they are serialized as the
+// locking reads of PostgreSQL serialize them, and wait for each other instead of failing.
+func sqliteDSN(dsn string) (string, error) {
+	parsed, err := url.Parse(dsn)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDSN, err)
+	}
+
+	path := parsed.Host + parsed.Path
+	if path == "" {
+		path = parsed.Opaque
+	}
+
+	if path == "" {
+		return "", fmt.Errorf("%w: the DSN names no database file", ErrDatabaseNameEmpty)
+	}
+
+	query := parsed.Query()
+	query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", sqliteBusyTimeout.Milliseconds()))
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Set("_txlock", "immediate")
+
+	return "file:" + path + "?" + query.Encode(), nil
+}
+
+// openSQLite opens a connection pool to the SQLite database file the DSN names,
+// or to an in-memory SQLite database for a memory:// DSN.
+func openSQLite(dsn string) (*bun.DB, error) {
+	driverDSN := "file::memory:"
+
+	if !isMemoryDSN(dsn) {
+		var err error
+		if driverDSN, err = sqliteDSN(dsn); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := registerSQLiteFunctions(); err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("sqlite", driverDSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the database: %w", err)
+	}
+
+	return bun.NewDB(db, sqlitedialect.New()), nil
+}
+
+// openDB opens a connection pool to the database of the DSN environment variable.
+func openDB() (*bun.DB, error) {
+	if dsn := os.Getenv("DSN"); isSQLiteDSN(dsn) || isMemoryDSN(dsn) {
+		return openSQLite(dsn)
+	}
+
+	dsn, err := databaseDSN()
+	if err != nil {
+		return nil, err
+	}
+
+	return openPostgres(dsn), nil
+}
+
+// isSQLite reports whether the database is a SQLite database.
+func (d *Database) isSQLite() bool {
+	return d.db.Dialect().Name() == dialect.SQLite
+}
+
+// forUpdate locks the rows read by the query until the end of its transaction.
+// SQLite has no row locks, but its transactions hold the write lock from the start.
+func forUpdate(query *bun.SelectQuery) *bun.SelectQuery {
+	if query.Dialect().Name() == dialect.SQLite {
+		return query
+	}
+
+	return query.For("UPDATE")
+}
+
+// translateSQLiteError classifies an error reported by SQLite by its result code.
+//
+// https://www.sqlite.org/rescode.html
+func translateSQLiteError(sqliteErr *sqlite.Error, err error) error {
+	switch code := sqliteErr.Code(); {
+	case code == sqlite3.SQLITE_CONSTRAINT_UNIQUE, code == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		// The message names the violated columns, as in "UNIQUE constraint failed: staff_members.email".
+		_, column, _ := strings.Cut(sqliteErr.Error(), "staff_members.")
+		column, _, _ = strings.Cut(column, " ")
+
+		return &ConflictError{Field: uniqueColumnFields[column]}
+	case code&0xff == sqlite3.SQLITE_BUSY, code&0xff == sqlite3.SQLITE_LOCKED:
+		return fmt.Errorf("%w: %w", ErrDatabaseUnavailable, err)
+	default:
+		return err
+	}
+}
+
+// registerSQLiteFunctions registers the SQL functions of the search query the PostgreSQL migrations
+// and pg_trgm provide, staff_search_normalize and word_similarity, with the SQLite driver.
+var registerSQLiteFunctions = sync.OnceValue(func() error {
+	err := sqlite.RegisterDeterministicScalarFunction("staff_search_normalize", 1,
+		func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+			value, ok := sqliteText(args[0])
+			if !ok {
+				return nil, nil
+			}
+
+			return searchNormalize(value), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register staff_search_normalize: %w", err)
+	}
+
+	err = sqlite.RegisterDeterministicScalarFunction("word_similarity", 2, //nolint:mnd // query and document
+		func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+			query, queryOK := sqliteText(args[0])
+			document, documentOK := sqliteText(args[1])
+
+			if !queryOK || !documentOK {
+				return nil, nil
+			}
+
+			return wordSimilarity(query, document), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register word_similarity: %w", err)
+	}
+
+	return nil
+})
+
+// sqliteText returns the text of an argument of a SQLite function, or false if it is NULL.
+func sqliteText(value driver.Value) (string, bool) {
+	switch value := value.(type) {
+	case string:
+		return value, true
+	case []byte:
+		return string(value), true
+	case nil:
+		return "", false
+	default:
+		return fmt.Sprint(value), true
+	}
+}
+
+// sqliteStaffEventListener wakes the watcher every sqliteEventPollInterval, as SQLite has no notifications.
+type sqliteStaffEventListener struct{}
+
+// listenSQLiteStaffEvents returns a listener polling the outbox.
+func (d *Database) listenSQLiteStaffEvents() StaffEventListener {
+	return sqliteStaffEventListener{}
+}
+
+// Receive implements StaffEventListener.
+func (sqliteStaffEventListener) Receive(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("failed to receive notification: %w", ctx.Err())
+	case <-time.After(sqliteEventPollInterval):
+		return nil
+	}
+}
+
+// Close implements StaffEventListener.
+func (sqliteStaffEventListener) Close() error {
+	return nil
+}
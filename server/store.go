@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+)
+
+// StaffStore stores the staff members, the audit log of their changes and the events announcing them.
+// Database, its only implementation, stores them in PostgreSQL, in a SQLite database file or in an in-memory
+// SQLite database, and reports the errors of this package, such as ErrStaffMemberNotFound and ConflictError,
+// under the same conditions for all of them.
+type StaffStore interface {
+	AddStaffMember(ctx context.Context, staff *spb.StaffMember) (*StaffMember, error)
+	GetStaffMember(ctx context.Context, staffID string, includeDeleted bool) (*StaffMember, error)
+	GetStaffMemberBySubject(ctx context.Context, subject string) (*StaffMember, error)
+	UpdateStaffMember(ctx context.Context, staff *spb.StaffMember, updateMask []string, etag string,
+	) (*StaffMember, error)
+	UpsertStaffMember(ctx context.Context, staff *spb.StaffMember, target UpsertTarget,
+	) (*StaffMember, UpsertResult, error)
+	DeleteStaffMember(ctx context.Context, id, etag string) error
+	RestoreStaffMember(ctx context.Context, id string) (*StaffMember, error)
+	PurgeStaffMember(ctx context.Context, id string) error
+
+	ListStaffMembers(ctx context.Context, params *ListStaffMembersParams) (*StaffMemberPage, error)
+	ForEachStaffMember(ctx context.Context, filter StaffMemberFilter, fn func(staff *StaffMember) error) error
+	SearchStaffMembers(ctx context.Context, query string, limit int) ([]*StaffMemberSearchResult, error)
+
+	GetStaffMembers(ctx context.Context, staffIDs []string, includeDeleted bool) ([]*StaffMember, error)
+	BatchAddStaffMembers(ctx context.Context, staffMembers []*spb.StaffMember, mode BatchMode,
+	) ([]BatchItemResult, error)
+	BatchUpdateStaffMembers(ctx context.Context, updates []StaffMemberUpdate, mode BatchMode,
+	) ([]BatchItemResult, error)
+	BatchDeleteStaffMembers(ctx context.Context, deletions []StaffMemberDeletion, mode BatchMode,
+	) ([]BatchItemResult, error)
+	ImportStaffMembers(ctx context.Context, rows []ImportRow, opts *ImportOptions) ([]ImportRowResult, error)
+
+	ListStaffAuditEvents(ctx context.Context, filter StaffAuditFilter, pageSize int, pageToken string,
+	) (*StaffAuditPage, error)
+
+	StaffEventsAfter(ctx context.Context, position staffEventPosition, limit int) ([]*StaffOutboxEvent, error)
+	StaffEvent(ctx context.Context, eventID int64) (*StaffOutboxEvent, error)
+	LastStaffEventPosition(ctx context.Context) (staffEventPosition, error)
+	ListenStaffEvents(ctx context.Context) (StaffEventListener, error)
+	RelayOutboxEvents(ctx context.Context, limit int, publish func(ctx context.Context, event *StaffOutboxEvent) error,
+	) (int, error)
+	PruneOutboxEvents(ctx context.Context, publishedBefore time.Time) error
+	DropUnpublishedOutboxEvents(ctx context.Context, createdBefore time.Time) (int64, error)
+
+	Ping(ctx context.Context) error
+	Close() error
+}
+
+var _ StaffStore = (*Database)(nil)
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrInvalidTracingConfig = errors.New("invalid tracing configuration")
+
+const (
+	// tracerName names the tracer of the spans of the service, such as those of its SQL queries.
+	tracerName = "github.com/BetterGR/staff-microservice/server"
+	// tracingServiceName is the service.name of the spans, unless OTEL_SERVICE_NAME sets another.
+	tracingServiceName = "staff-microservice"
+	// maxTracedStatementLength caps the statements recorded on spans, as batches insert hundreds of rows.
+	maxTracedStatementLength = 4096
+)
+
+var (
+	// sqlStringLiterals matches the string literals of SQL statements, with their '' escapes,
+	// and the E'' and X'' prefixes of escaped strings and blobs.
+	sqlStringLiterals = regexp.MustCompile(`(?i)(?:\b[ex])?'(?:[^']|'')*'`)
+	// sqlNumericLiterals matches the numeric literals of SQL statements, but not the digits of identifiers.
+	sqlNumericLiterals = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+)
+
+// setupTracing installs the W3C trace context and baggage propagators, and the tracer provider exporting
+// the spans with the exporter named by OTEL_TRACES_EXPORTER:
+//
+//   - "otlp", the default if OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set,
+//     exports them over OTLP, with the protocol of OTEL_EXPORTER_OTLP_PROTOCOL ("grpc" or "http/protobuf");
+//   - "console" writes them to the standard output, for local runs;
+//   - "none", the default otherwise, does not record them.
+//
+// The exporters, sampler and resource read the rest of their configuration from the standard
+// OTEL_* environment variables. It returns the function flushing the spans and stopping the provider.
+func setupTracing(ctx context.Context) (func(ctx context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{},
+		propagation.Baggage{}))
+
+	exporter, err := newSpanExporter(ctx)
+	if err != nil || exporter == nil {
+		return func(context.Context) error { return nil }, err
+	}
+
+	// The attributes of the environment take precedence over the default service name.
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(tracingServiceName)),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// newSpanExporter returns the exporter named by OTEL_TRACES_EXPORTER, or nil if spans are not exported.
+func newSpanExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	name := os.Getenv("OTEL_TRACES_EXPORTER")
+	if name == "" {
+		name = "none"
+		if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "" {
+			name = "otlp"
+		}
+	}
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+
+	switch name {
+	case "none":
+		return nil, nil //nolint:nilnil // no exporter is configured
+	case "console":
+		exporter, err = stdouttrace.New()
+	case "otlp":
+		switch protocol := os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"); protocol {
+		case "", "grpc":
+			exporter, err = otlptracegrpc.New(ctx)
+		case "http/protobuf":
+			exporter, err = otlptracehttp.New(ctx)
+		default:
+			return nil, fmt.Errorf("%w: unsupported OTEL_EXPORTER_OTLP_PROTOCOL %q", ErrInvalidTracingConfig, protocol)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported OTEL_TRACES_EXPORTER %q", ErrInvalidTracingConfig, name)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s span exporter: %w", name, err)
+	}
+
+	return exporter, nil
+}
+
+// sanitizeStatement returns the SQL statement with its literals replaced by ?, so that the values
+// of the staff members, such as their emails and phone numbers, are not recorded on spans.
+func sanitizeStatement(query string) string {
+	query = sqlStringLiterals.ReplaceAllString(query, "?")
+	query = sqlNumericLiterals.ReplaceAllString(query, "?")
+
+	if len(query) > maxTracedStatementLength {
+		// Cut on a rune boundary, as exporters reject attributes that are not valid UTF-8.
+		end := maxTracedStatementLength
+		for end > 0 && !utf8.RuneStart(query[end]) {
+			end--
+		}
+
+		query = query[:end] + "..."
+	}
+
+	return query
+}
+
+// tracingQueryHook is the bun query hook recording every SQL query as a span, a child of the span
+// of the RPC running it.
+type tracingQueryHook struct {
+	system attribute.KeyValue
+}
+
+var _ bun.QueryHook = (*tracingQueryHook)(nil)
+
+// newTracingQueryHook returns the hook tracing the queries of the database of the dialect.
+func newTracingQueryHook(name dialect.Name) *tracingQueryHook {
+	system := semconv.DBSystemPostgreSQL
+	if name == dialect.SQLite {
+		system = semconv.DBSystemSqlite
+	}
+
+	return &tracingQueryHook{system: system}
+}
+
+// BeforeQuery implements bun.QueryHook. The tracer is looked up on every query, so that
+// the hook follows the tracer provider installed after the database was connected.
+func (h *tracingQueryHook) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	operation := event.Operation()
+
+	ctx, _ = otel.Tracer(tracerName).Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(event.StartTime),
+		trace.WithAttributes(
+			h.system,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(sanitizeStatement(event.Query)),
+		))
+
+	return ctx
+}
+
+// AfterQuery implements bun.QueryHook. Queries finding no rows did not fail.
+func (h *tracingQueryHook) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if !span.IsRecording() {
+		return
+	}
+
+	if event.Result != nil {
+		if rows, err := event.Result.RowsAffected(); err == nil {
+			span.SetAttributes(attribute.Int64("db.rows_affected", rows))
+		}
+	}
+
+	if event.Err != nil && !errors.Is(event.Err, sql.ErrNoRows) {
+		span.RecordError(event.Err)
+		span.SetStatus(codes.Error, event.Err.Error())
+	}
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrStaffMemberDeleted = errors.New("staff member is deleted")
+	// ErrUpsertConflict means the staff member an upsert conflicted with kept being purged before it was read.
+	ErrUpsertConflict = errors.New("staff member was purged concurrently with the upsert")
+)
+
+// UpsertTarget is the unique field identifying the staff member an upsert updates.
+type UpsertTarget int
+
+const (
+	UpsertOnStaffID UpsertTarget = iota
+	UpsertOnEmail
+)
+
+// UpsertResult is the outcome of an upsert.
+type UpsertResult int
+
+const (
+	UpsertCreated UpsertResult = iota + 1
+	UpsertUpdated
+	// UpsertUnchanged means the existing staff member already had the given fields and was not written.
+	UpsertUnchanged
+)
+
+// upsertResults maps the outcomes of upserts to their protobuf representation.
+var upsertResults = map[UpsertResult]spb.UpsertResult{
+	UpsertCreated:   spb.UpsertResult_UPSERT_RESULT_CREATED,
+	UpsertUpdated:   spb.UpsertResult_UPSERT_RESULT_UPDATED,
+	UpsertUnchanged: spb.UpsertResult_UPSERT_RESULT_UNCHANGED,
+}
+
+// upsertInsertAttempts bounds the inserts of an upsert, which inserts again if the staff member
+// it conflicted with is purged before it is read.
+const upsertInsertAttempts = 3
+
+// upsertMask lists the fields an upsert sets on an existing staff member.
+// Their subject is left as it is, as only admins may change it.
+var upsertMask = []string{"firstName", "lastName", "email", "phoneNumber", "title", "office"}
+
+// UpsertStaffMember creates a staff member, or updates the staff member with the same target field.
+// The staff member is inserted with ON CONFLICT DO NOTHING, which waits for concurrent inserts of the
+// same staff member to commit, so that exactly one of several concurrent upserts creates it.
+// Existing staff members are then updated as by UpdateStaffMember, or fail with ErrStaffMemberDeleted if deleted.
+// Upserting on the staffID fails with ErrStaffMemberIDEmpty without one, and upserting on the email
+// fails with ErrStaffIDMismatch if the staffID is set but is not the one of the staff member with the email.
+func (d *Database) UpsertStaffMember(ctx context.Context, staff *spb.StaffMember, target UpsertTarget,
+) (*StaffMember, UpsertResult, error) {
+	var (
+		upserted *StaffMember
+		result   UpsertResult
+	)
+
+	err := d.runInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		upserted, result, err = upsertStaffMember(ctx, tx, staff, target)
+
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return upserted, result, nil
+}
+
+// upsertStaffMember creates or updates a staff member within a transaction.
+func upsertStaffMember(ctx context.Context, tx bun.Tx, staff *spb.StaffMember, target UpsertTarget,
+) (*StaffMember, UpsertResult, error) {
+	if staff == nil {
+		return nil, 0, fmt.Errorf("%w", ErrStaffMemberNil)
+	}
+
+	newStaffMember, err := newStaffMemberRow(ctx, staff)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	column, key := "staff_id", staff.GetStaffID()
+	if target == UpsertOnEmail {
+		column, key = "email", newStaffMember.Email
+	}
+
+	if key == "" {
+		// newStaffMemberRow generated a staffID, which conflicts with no staff member.
+		return nil, 0, fmt.Errorf("%w", ErrStaffMemberIDEmpty)
+	}
+
+	var existing *StaffMember
+
+	for attempt := 1; existing == nil; attempt++ {
+		res, err := tx.NewInsert().
+			Model(newStaffMember).
+			On("CONFLICT (?) DO NOTHING", bun.Ident(column)).
+			Returning("*").
+			Exec(ctx)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to upsert staff member: %w", translateDBError(err))
+		}
+
+		if num, _ := res.RowsAffected(); num > 0 {
+			if err := recordChange(ctx, tx, auditActionCreate, nil, newStaffMember); err != nil {
+				return nil, 0, err
+			}
+
+			return newStaffMember, UpsertCreated, nil
+		}
+
+		existing = new(StaffMember)
+		if err := forUpdate(tx.NewSelect().
+			Model(existing).
+			Where("? = ?", bun.Ident(column), key).
+			WhereAllWithDeleted()).
+			Scan(ctx); err != nil {
+			err = translateDBError(err)
+			if !errors.Is(err, ErrStaffMemberNotFound) {
+				return nil, 0, fmt.Errorf("failed to upsert staff member: %w", err)
+			}
+
+			// The conflicting staff member was purged since the insert, which can now create it.
+			if attempt == upsertInsertAttempts {
+				return nil, 0, fmt.Errorf("%w", ErrUpsertConflict)
+			}
+
+			existing = nil
+		}
+	}
+
+	if !existing.DeletedAt.IsZero() {
+		return nil, 0, fmt.Errorf("%w", ErrStaffMemberDeleted)
+	}
+
+	if staff.GetStaffID() != "" && staff.GetStaffID() != existing.StaffID {
+		return nil, 0, fmt.Errorf("%w", ErrStaffIDMismatch)
+	}
+
+	update := proto.Clone(staff).(*spb.StaffMember) //nolint:forcetypeassert // Clone returns the type it is given
+	update.StaffID = existing.StaffID
+
+	if !changesStaffMember(existing, update, upsertMask) {
+		return existing, UpsertUnchanged, nil
+	}
+
+	updated, err := updateStaffMember(ctx, tx, update, upsertMask, "")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return updated, UpsertUpdated, nil
+}
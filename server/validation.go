@@ -0,0 +1,211 @@
+package main
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxIDLength is the maximal length of a staffID.
+	maxIDLength = 64
+	// maxNameLength is the maximal length of names, titles and offices.
+	maxNameLength = 100
+	// maxEmailLength is the maximal length of an email address, as limited by RFC 5321.
+	maxEmailLength = 254
+	// maxSubjectLength is the maximal length of a token subject, as limited by OpenID Connect.
+	maxSubjectLength = 255
+)
+
+// e164Pattern matches phone numbers in E.164 format, such as +972501234567.
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// fieldRule describes the constraints on a single StaffMember field.
+type fieldRule struct {
+	// path is the name of the field in the StaffMember message.
+	path      string
+	value     func(staff *spb.StaffMember) string
+	required  bool
+	maxLength int
+	// format returns a description of what is wrong with a non-empty value, or "" if it is valid.
+	format func(value string) string
+}
+
+// staffMemberRules lists the constraints on the fields of a StaffMember.
+var staffMemberRules = []fieldRule{
+	{
+		// The staffID is assigned by the server on creation, but identifies the staff member on update.
+		path: "staffID", value: (*spb.StaffMember).GetStaffID,
+		maxLength: maxIDLength,
+	},
+	{
+		path: "firstName", value: (*spb.StaffMember).GetFirstName,
+		required: true, maxLength: maxNameLength,
+	},
+	{
+		path: "lastName", value: (*spb.StaffMember).GetLastName,
+		required: true, maxLength: maxNameLength,
+	},
+	{
+		path: "email", value: (*spb.StaffMember).GetEmail,
+		required: true, maxLength: maxEmailLength, format: validateEmail,
+	},
+	{
+		path: "phoneNumber", value: (*spb.StaffMember).GetPhoneNumber,
+		required: true, format: validatePhoneNumber,
+	},
+	{
+		path: "title", value: (*spb.StaffMember).GetTitle,
+		maxLength: maxNameLength,
+	},
+	{
+		path: "office", value: (*spb.StaffMember).GetOffice,
+		maxLength: maxNameLength,
+	},
+	{
+		path: "subject", value: (*spb.StaffMember).GetSubject,
+		maxLength: maxSubjectLength,
+	},
+}
+
+// validateEmail checks that the value is a bare RFC 5322 address, without a display name.
+func validateEmail(value string) string {
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Name != "" || address.Address != value {
+		return "must be a valid email address"
+	}
+
+	return ""
+}
+
+// validatePhoneNumber checks that the value is an E.164 phone number.
+func validatePhoneNumber(value string) string {
+	if !e164Pattern.MatchString(value) {
+		return "must be an E.164 phone number, such as +972501234567"
+	}
+
+	return ""
+}
+
+// check returns the violations of the rule by the given value.
+func (r fieldRule) check(value string, required bool) []*errdetails.BadRequest_FieldViolation {
+	field := "staffMember." + r.path
+
+	if strings.TrimSpace(value) == "" {
+		if required {
+			return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: "is required"}}
+		}
+
+		return nil
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if r.maxLength > 0 && utf8.RuneCountInString(value) > r.maxLength {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field: field, Description: fmt.Sprintf("must be at most %d characters long", r.maxLength),
+		})
+	}
+
+	if r.format != nil {
+		if description := r.format(value); description != "" {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field: field, Description: description,
+			})
+		}
+	}
+
+	return violations
+}
+
+// validateNewStaffMember checks every field of a staff member that is about to be created.
+func validateNewStaffMember(staff *spb.StaffMember) error {
+	if staff == nil {
+		return invalidArgument([]*errdetails.BadRequest_FieldViolation{
+			{Field: "staffMember", Description: "is required"},
+		})
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, rule := range staffMemberRules {
+		violations = append(violations, rule.check(rule.value(staff), rule.required)...)
+	}
+
+	return invalidArgument(violations)
+}
+
+// validateUpsertTarget checks that the staff member has the field identifying the staff member an upsert updates.
+// The email is required anyway, but the staffID is otherwise generated.
+func validateUpsertTarget(staff *spb.StaffMember, target spb.UpsertConflictTarget) error {
+	if target != spb.UpsertConflictTarget_UPSERT_ON_STAFF_ID || staff.GetStaffID() != "" {
+		return nil
+	}
+
+	return invalidArgument([]*errdetails.BadRequest_FieldViolation{
+		{Field: "staffMember.staffID", Description: "is required to upsert on the staffID"},
+	})
+}
+
+// validateStaffMemberUpdate checks the fields an update would set.
+// With an update mask, every masked field is checked, so required fields cannot be cleared.
+// Without one, only the non-empty fields, which are the ones being updated, are checked.
+func validateStaffMemberUpdate(staff *spb.StaffMember, updateMask []string) error {
+	if staff == nil {
+		return invalidArgument([]*errdetails.BadRequest_FieldViolation{
+			{Field: "staffMember", Description: "is required"},
+		})
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	for _, path := range updateMask {
+		if _, ok := updatableStaffMemberFields[path]; !ok {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field: "updateMask.paths", Description: fmt.Sprintf("%q is not an updatable field", path),
+			})
+		}
+	}
+
+	for _, rule := range staffMemberRules {
+		switch {
+		case rule.path == "staffID":
+			violations = append(violations, rule.check(staff.GetStaffID(), true)...)
+		case len(updateMask) == 0:
+			violations = append(violations, rule.check(rule.value(staff), false)...)
+		case slices.Contains(updateMask, rule.path):
+			violations = append(violations, rule.check(rule.value(staff), rule.required)...)
+		}
+	}
+
+	return invalidArgument(violations)
+}
+
+// invalidArgument returns an InvalidArgument status error listing every violation,
+// or nil if there are none.
+func invalidArgument(violations []*errdetails.BadRequest_FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		descriptions = append(descriptions, violation.GetField()+" "+violation.GetDescription())
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid staff member: "+strings.Join(descriptions, "; "))
+
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -0,0 +1,471 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	spb "github.com/BetterGR/staff-microservice/protos"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+)
+
+var (
+	ErrInvalidRevision = errors.New("invalid revision")
+	ErrRevisionExpired = errors.New("revision is too old to resume from")
+	ErrWatcherTooSlow  = errors.New("watcher fell behind the changes")
+)
+
+const (
+	// staffEventsChannel is the PostgreSQL notification channel announcing the IDs of committed staff events.
+	staffEventsChannel = "staff_events"
+	// watchBufferSize is the number of changes buffered for a watcher before it is disconnected as too slow.
+	watchBufferSize = 256
+	// watchCatchUpBatchSize is the number of events read at a time when catching up on missed events.
+	watchCatchUpBatchSize = 500
+	// watchPollInterval is how often the outbox is read without notifications, for the events held back
+	// by transactions that wrote no event.
+	watchPollInterval = time.Second
+	// watchRetryInterval is the wait before listening again after losing the notification connection.
+	watchRetryInterval = time.Second
+)
+
+// staffEventsVisible selects the staff events whose position no event committed later can precede:
+// those written by transactions older than every running transaction of the PostgreSQL cluster.
+// A long transaction holds back the events of the transactions that started after it until it ends.
+const staffEventsVisible = "xact_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint"
+
+// staffEventPosition is the position of a staff event in the order they are streamed to watchers:
+// by the ID of the transaction that wrote it, then by its ID. Event IDs are allocated before their
+// transactions commit, so a transaction committing after another may hold lower ones; the transaction
+// ID of events is only compared once the transactions before it ended, see staffEventsVisible.
+// SQLite serializes its transactions, so there its events are ordered by their IDs alone.
+type staffEventPosition struct {
+	XactID  int64
+	EventID int64
+}
+
+// after reports whether the position follows the other one.
+func (p staffEventPosition) after(other staffEventPosition) bool {
+	if p.XactID != other.XactID {
+		return p.XactID > other.XactID
+	}
+
+	return p.EventID > other.EventID
+}
+
+// staffChange is a committed staff event, as streamed to watchers.
+type staffChange struct {
+	position staffEventPosition
+	revision string
+	event    *spb.StaffEvent
+}
+
+// revisionToken is the decoded form of a revision.
+// It holds the time of the event so that revisions of pruned events can be told apart.
+type revisionToken struct {
+	XactID    int64     `json:"x,omitempty"`
+	EventID   int64     `json:"r"`
+	CreatedAt time.Time `json:"t"`
+}
+
+// newStaffChange decodes an outbox event.
+func newStaffChange(event *StaffOutboxEvent) (*staffChange, error) {
+	staffEvent := new(spb.StaffEvent)
+	if err := proto.Unmarshal(event.Payload, staffEvent); err != nil {
+		return nil, fmt.Errorf("failed to decode staff event %d: %w", event.EventID, err)
+	}
+
+	data, err := json.Marshal(revisionToken{XactID: event.XactID, EventID: event.EventID, CreatedAt: event.CreatedAt})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode revision: %w", err)
+	}
+
+	return &staffChange{
+		position: event.position(),
+		revision: base64.RawURLEncoding.EncodeToString(data),
+		event:    staffEvent,
+	}, nil
+}
+
+// parseRevision returns the position of the event identified by a revision.
+// Revisions older than the outbox retention fail with ErrRevisionExpired,
+// as the events after them may have been pruned.
+func parseRevision(revision string) (staffEventPosition, error) {
+	data, err := base64.RawURLEncoding.DecodeString(revision)
+	if err != nil {
+		return staffEventPosition{}, fmt.Errorf("%w", ErrInvalidRevision)
+	}
+
+	var token revisionToken
+	if err := json.Unmarshal(data, &token); err != nil || token.EventID <= 0 || token.XactID < 0 {
+		return staffEventPosition{}, fmt.Errorf("%w", ErrInvalidRevision)
+	}
+
+	if time.Since(token.CreatedAt) > outboxRetention {
+		return staffEventPosition{}, fmt.Errorf("%w", ErrRevisionExpired)
+	}
+
+	return staffEventPosition{XactID: token.XactID, EventID: token.EventID}, nil
+}
+
+// notifyStaffEvent announces a staff event to the watchers of every replica once the transaction commits.
+// SQLite listeners poll the outbox instead.
+func notifyStaffEvent(ctx context.Context, tx bun.IDB) error {
+	if tx.Dialect().Name() == dialect.SQLite {
+		return nil
+	}
+
+	// Notifications with the same payload in a transaction are delivered once.
+	if _, err := tx.NewRaw("SELECT pg_notify(?, '')", staffEventsChannel).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to notify staff event: %w", translateDBError(err))
+	}
+
+	return nil
+}
+
+// StaffEventsAfter returns up to limit events following the given position, in order.
+// Events are returned once no event committed later can precede them.
+func (d *Database) StaffEventsAfter(ctx context.Context, position staffEventPosition, limit int,
+) ([]*StaffOutboxEvent, error) {
+	var events []*StaffOutboxEvent
+
+	err := d.read(ctx, func(ctx context.Context) error {
+		if err := d.visibleStaffEvents(d.db.NewSelect().
+			Model(&events).
+			Where("(xact_id, event_id) > (?, ?)", position.XactID, position.EventID).
+			OrderExpr("xact_id, event_id").
+			Limit(limit)).
+			Scan(ctx); err != nil {
+			return fmt.Errorf("failed to read staff events: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// StaffEvent returns the event with the given ID.
+func (d *Database) StaffEvent(ctx context.Context, eventID int64) (*StaffOutboxEvent, error) {
+	event := &StaffOutboxEvent{EventID: eventID}
+
+	err := d.read(ctx, func(ctx context.Context) error {
+		if err := d.db.NewSelect().Model(event).WherePK().Scan(ctx); err != nil {
+			return fmt.Errorf("failed to read staff event: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// LastStaffEventPosition returns the position of the latest staff event StaffEventsAfter returns,
+// or the zero position if there are none.
+func (d *Database) LastStaffEventPosition(ctx context.Context) (staffEventPosition, error) {
+	event := new(StaffOutboxEvent)
+
+	err := d.read(ctx, func(ctx context.Context) error {
+		err := d.visibleStaffEvents(d.db.NewSelect().
+			Model(event).
+			Column("xact_id", "event_id").
+			OrderExpr("xact_id DESC, event_id DESC").
+			Limit(1)).
+			Scan(ctx)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to read last staff event: %w", translateDBError(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return staffEventPosition{}, err
+	}
+
+	return event.position(), nil
+}
+
+// visibleStaffEvents restricts a query of staff events to those no event committed later can precede.
+func (d *Database) visibleStaffEvents(query *bun.SelectQuery) *bun.SelectQuery {
+	if d.isSQLite() {
+		return query
+	}
+
+	return query.Where(staffEventsVisible)
+}
+
+// StaffEventListener is notified of the staff events committed by every replica.
+type StaffEventListener interface {
+	// Receive waits until staff events may have been committed, or for at most watchPollInterval,
+	// as some events are held back until other transactions end. After it fails, notifications
+	// may have been missed, and the listener must be closed.
+	Receive(ctx context.Context) error
+	Close() error
+}
+
+// ListenStaffEvents returns a listener of the events committed from now on, notified by PostgreSQL.
+func (d *Database) ListenStaffEvents(ctx context.Context) (StaffEventListener, error) {
+	if d.isSQLite() {
+		return d.listenSQLiteStaffEvents(), nil
+	}
+
+	listener := pgdriver.NewListener(d.db)
+
+	if err := listener.Listen(ctx, staffEventsChannel); err != nil {
+		_ = listener.Close()
+
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	return &pgStaffEventListener{listener: listener}, nil
+}
+
+// pgStaffEventListener receives the notifications of the staff events channel.
+type pgStaffEventListener struct {
+	listener *pgdriver.Listener
+}
+
+// Receive implements StaffEventListener.
+func (l *pgStaffEventListener) Receive(ctx context.Context) error {
+	var netErr net.Error
+
+	_, _, err := l.listener.ReceiveTimeout(ctx, watchPollInterval)
+	if err != nil && !(errors.As(err, &netErr) && netErr.Timeout() && ctx.Err() == nil) {
+		return fmt.Errorf("failed to receive notification: %w", err)
+	}
+
+	return nil
+}
+
+// Close implements StaffEventListener.
+func (l *pgStaffEventListener) Close() error {
+	if err := l.listener.Close(); err != nil {
+		return fmt.Errorf("failed to close listener: %w", err)
+	}
+
+	return nil
+}
+
+// watchSubscription receives the changes broadcast by a StaffWatcher.
+// Its channel is closed if it falls more than watchBufferSize changes behind.
+type watchSubscription struct {
+	changes chan *staffChange
+}
+
+// StaffWatcher fans out the staff events committed by every replica to the WatchStaffMembers
+// streams of this replica. It reads the new events from the store whenever it is notified of them,
+// so all replicas stream the same events in the same order, that of their positions.
+type StaffWatcher struct {
+	store StaffStore
+
+	mu            sync.Mutex
+	subscriptions map[*watchSubscription]struct{}
+	// position is the position of the latest event broadcast, from which to read the next ones.
+	position staffEventPosition
+
+	// done is closed once the watcher stopped broadcasting.
+	done chan struct{}
+}
+
+// NewStaffWatcher returns a watcher of the staff events of the store.
+func NewStaffWatcher(store StaffStore) *StaffWatcher {
+	return &StaffWatcher{
+		store:         store,
+		subscriptions: make(map[*watchSubscription]struct{}),
+		done:          make(chan struct{}),
+	}
+}
+
+// subscribe returns a subscription to the changes broadcast from now on.
+func (w *StaffWatcher) subscribe() *watchSubscription {
+	sub := &watchSubscription{changes: make(chan *staffChange, watchBufferSize)}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.subscriptions[sub] = struct{}{}
+
+	return sub
+}
+
+// unsubscribe stops broadcasting changes to the subscription.
+func (w *StaffWatcher) unsubscribe(sub *watchSubscription) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.subscriptions, sub)
+}
+
+// broadcast sends an event to every subscription, dropping the subscriptions whose buffer is full.
+// Events that do not follow the last broadcast one are ignored.
+func (w *StaffWatcher) broadcast(ctx context.Context, event *StaffOutboxEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !event.position().after(w.position) {
+		return
+	}
+
+	w.position = event.position()
+
+	change, err := newStaffChange(event)
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to broadcast staff event")
+
+		return
+	}
+
+	for sub := range w.subscriptions {
+		select {
+		case sub.changes <- change:
+		default:
+			close(sub.changes)
+			delete(w.subscriptions, sub)
+		}
+	}
+}
+
+// Done returns a channel closed once the watcher stopped broadcasting, after the context of Start is canceled.
+func (w *StaffWatcher) Done() <-chan struct{} {
+	return w.done
+}
+
+// Start broadcasts the events committed from now on until the context is canceled.
+func (w *StaffWatcher) Start(ctx context.Context) error {
+	position, err := w.store.LastStaffEventPosition(ctx)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	w.position = position
+	w.mu.Unlock()
+
+	go w.run(ctx)
+
+	return nil
+}
+
+// run broadcasts the events committed to the store until the context is canceled.
+// After losing the notifications, it listens again and catches up on the events it missed.
+func (w *StaffWatcher) run(ctx context.Context) {
+	defer close(w.done)
+
+	logger := klog.FromContext(ctx)
+
+	for ctx.Err() == nil {
+		if err := w.listen(ctx); err != nil && ctx.Err() == nil {
+			logger.Error(err, "Lost staff event notifications", "retryIn", watchRetryInterval)
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(watchRetryInterval):
+			}
+		}
+	}
+}
+
+// listen broadcasts the events following the last broadcast one whenever the store notifies
+// it of new events, until the notifications are lost.
+func (w *StaffWatcher) listen(ctx context.Context) error {
+	listener, err := w.store.ListenStaffEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer listener.Close()
+
+	for {
+		if err := w.catchUp(ctx); err != nil {
+			return err
+		}
+
+		if err := listener.Receive(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// catchUp broadcasts the events following the last broadcast one.
+func (w *StaffWatcher) catchUp(ctx context.Context) error {
+	for {
+		w.mu.Lock()
+		position := w.position
+		w.mu.Unlock()
+
+		events, err := w.store.StaffEventsAfter(ctx, position, watchCatchUpBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			w.broadcast(ctx, event)
+		}
+
+		if len(events) < watchCatchUpBatchSize {
+			return nil
+		}
+	}
+}
+
+// staffWatchFilter selects the changes streamed to a watcher.
+type staffWatchFilter struct {
+	staffIDs map[string]struct{}
+	offices  map[string]struct{}
+}
+
+// newStaffWatchFilter returns a filter matching the given staffIDs and offices.
+func newStaffWatchFilter(staffIDs, offices []string) *staffWatchFilter {
+	filter := &staffWatchFilter{}
+
+	if len(staffIDs) > 0 {
+		filter.staffIDs = make(map[string]struct{}, len(staffIDs))
+		for _, staffID := range staffIDs {
+			filter.staffIDs[staffID] = struct{}{}
+		}
+	}
+
+	if len(offices) > 0 {
+		filter.offices = make(map[string]struct{}, len(offices))
+		for _, office := range offices {
+			filter.offices[office] = struct{}{}
+		}
+	}
+
+	return filter
+}
+
+// matches reports whether the change is streamed to the watcher.
+func (f *staffWatchFilter) matches(change *staffChange) bool {
+	staff := change.event.GetStaffMember()
+
+	if f.staffIDs != nil {
+		if _, ok := f.staffIDs[staff.GetStaffID()]; !ok {
+			return false
+		}
+	}
+
+	if f.offices != nil {
+		if _, ok := f.offices[staff.GetOffice()]; !ok {
+			return false
+		}
+	}
+
+	return true
+}